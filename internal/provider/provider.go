@@ -434,11 +434,12 @@ func Provider() *schema.Provider {
 			"aws_autoscaling_groups":   autoscaling.DataSourceGroups(),
 			"aws_launch_configuration": autoscaling.DataSourceLaunchConfiguration(),
 
-			"aws_backup_framework":   backup.DataSourceFramework(),
-			"aws_backup_plan":        backup.DataSourcePlan(),
-			"aws_backup_report_plan": backup.DataSourceReportPlan(),
-			"aws_backup_selection":   backup.DataSourceSelection(),
-			"aws_backup_vault":       backup.DataSourceVault(),
+			"aws_backup_framework":      backup.DataSourceFramework(),
+			"aws_backup_plan":           backup.DataSourcePlan(),
+			"aws_backup_recovery_point": backup.DataSourceRecoveryPoint(),
+			"aws_backup_report_plan":    backup.DataSourceReportPlan(),
+			"aws_backup_selection":      backup.DataSourceSelection(),
+			"aws_backup_vault":          backup.DataSourceVault(),
 
 			"aws_batch_compute_environment": batch.DataSourceComputeEnvironment(),
 			"aws_batch_job_queue":           batch.DataSourceJobQueue(),
@@ -463,9 +464,12 @@ func Provider() *schema.Provider {
 
 			"aws_cloudtrail_service_account": cloudtrail.DataSourceServiceAccount(),
 
-			"aws_cloudwatch_event_bus":        events.DataSourceBus(),
-			"aws_cloudwatch_event_connection": events.DataSourceConnection(),
-			"aws_cloudwatch_event_source":     events.DataSourceSource(),
+			"aws_cloudwatch_dashboard_document": cloudwatch.DataSourceDashboardDocument(),
+
+			"aws_cloudwatch_event_bus":           events.DataSourceBus(),
+			"aws_cloudwatch_event_connection":    events.DataSourceConnection(),
+			"aws_cloudwatch_event_pattern_match": events.DataSourcePatternMatch(),
+			"aws_cloudwatch_event_source":        events.DataSourceSource(),
 
 			"aws_cloudwatch_log_group":  cloudwatchlogs.DataSourceGroup(),
 			"aws_cloudwatch_log_groups": cloudwatchlogs.DataSourceGroups(),
@@ -603,6 +607,7 @@ func Provider() *schema.Provider {
 			"aws_eks_cluster":       eks.DataSourceCluster(),
 			"aws_eks_clusters":      eks.DataSourceClusters(),
 			"aws_eks_cluster_auth":  eks.DataSourceClusterAuth(),
+			"aws_eks_kubeconfig":    eks.DataSourceKubeconfig(),
 			"aws_eks_node_group":    eks.DataSourceNodeGroup(),
 			"aws_eks_node_groups":   eks.DataSourceNodeGroups(),
 
@@ -690,12 +695,13 @@ func Provider() *schema.Provider {
 			"aws_kinesis_stream":          kinesis.DataSourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.DataSourceStreamConsumer(),
 
-			"aws_kms_alias":      kms.DataSourceAlias(),
-			"aws_kms_ciphertext": kms.DataSourceCiphertext(),
-			"aws_kms_key":        kms.DataSourceKey(),
-			"aws_kms_public_key": kms.DataSourcePublicKey(),
-			"aws_kms_secret":     kms.DataSourceSecret(),
-			"aws_kms_secrets":    kms.DataSourceSecrets(),
+			"aws_kms_alias":            kms.DataSourceAlias(),
+			"aws_kms_ciphertext":       kms.DataSourceCiphertext(),
+			"aws_kms_custom_key_store": kms.DataSourceCustomKeyStore(),
+			"aws_kms_key":              kms.DataSourceKey(),
+			"aws_kms_public_key":       kms.DataSourcePublicKey(),
+			"aws_kms_secret":           kms.DataSourceSecret(),
+			"aws_kms_secrets":          kms.DataSourceSecrets(),
 
 			"aws_lakeformation_data_lake_settings": lakeformation.DataSourceDataLakeSettings(),
 			"aws_lakeformation_permissions":        lakeformation.DataSourcePermissions(),
@@ -749,9 +755,11 @@ func Provider() *schema.Provider {
 
 			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
 			"aws_organizations_delegated_services":       organizations.DataSourceDelegatedServices(),
+			"aws_organizations_effective_policy":         organizations.DataSourceEffectivePolicy(),
 			"aws_organizations_organization":             organizations.DataSourceOrganization(),
 			"aws_organizations_organizational_units":     organizations.DataSourceOrganizationalUnits(),
 			"aws_organizations_resource_tags":            organizations.DataSourceResourceTags(),
+			"aws_organizations_tag_policy_compliance":    organizations.DataSourceTagPolicyCompliance(),
 
 			"aws_outposts_outpost":                outposts.DataSourceOutpost(),
 			"aws_outposts_outpost_instance_type":  outposts.DataSourceOutpostInstanceType(),
@@ -790,6 +798,8 @@ func Provider() *schema.Provider {
 			"aws_route53_delegation_set":          route53.DataSourceDelegationSet(),
 			"aws_route53_traffic_policy_document": route53.DataSourceTrafficPolicyDocument(),
 			"aws_route53_zone":                    route53.DataSourceZone(),
+			"aws_route53_zone_export":             route53.DataSourceZoneExport(),
+			"aws_route53_zone_file":               route53.DataSourceZoneFile(),
 
 			"aws_route53_resolver_endpoint": route53resolver.DataSourceEndpoint(),
 			"aws_route53_resolver_rule":     route53resolver.DataSourceRule(),
@@ -990,6 +1000,7 @@ func Provider() *schema.Provider {
 			"aws_backup_plan":                     backup.ResourcePlan(),
 			"aws_backup_region_settings":          backup.ResourceRegionSettings(),
 			"aws_backup_report_plan":              backup.ResourceReportPlan(),
+			"aws_backup_restore_job":              backup.ResourceRestoreJob(),
 			"aws_backup_selection":                backup.ResourceSelection(),
 			"aws_backup_vault":                    backup.ResourceVault(),
 			"aws_backup_vault_lock_configuration": backup.ResourceVaultLockConfiguration(),
@@ -1550,6 +1561,7 @@ func Provider() *schema.Provider {
 
 			"aws_kms_alias":                kms.ResourceAlias(),
 			"aws_kms_ciphertext":           kms.ResourceCiphertext(),
+			"aws_kms_custom_key_store":     kms.ResourceCustomKeyStore(),
 			"aws_kms_external_key":         kms.ResourceExternalKey(),
 			"aws_kms_grant":                kms.ResourceGrant(),
 			"aws_kms_key":                  kms.ResourceKey(),
@@ -1730,12 +1742,15 @@ func Provider() *schema.Provider {
 
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
+			"aws_route53_cidr_collection":               route53.ResourceCIDRCollection(),
+			"aws_route53_cidr_location":                 route53.ResourceCIDRLocation(),
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
 			"aws_route53_health_check":                  route53.ResourceHealthCheck(),
 			"aws_route53_hosted_zone_dnssec":            route53.ResourceHostedZoneDNSSEC(),
 			"aws_route53_key_signing_key":               route53.ResourceKeySigningKey(),
 			"aws_route53_query_log":                     route53.ResourceQueryLog(),
 			"aws_route53_record":                        route53.ResourceRecord(),
+			"aws_route53_records":                       route53.ResourceRecords(),
 			"aws_route53_traffic_policy":                route53.ResourceTrafficPolicy(),
 			"aws_route53_traffic_policy_instance":       route53.ResourceTrafficPolicyInstance(),
 			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(),
@@ -1832,6 +1847,7 @@ func Provider() *schema.Provider {
 			"aws_schemas_schema":     schemas.ResourceSchema(),
 
 			"aws_secretsmanager_secret":          secretsmanager.ResourceSecret(),
+			"aws_secretsmanager_secret_key":      secretsmanager.ResourceSecretKey(),
 			"aws_secretsmanager_secret_policy":   secretsmanager.ResourceSecretPolicy(),
 			"aws_secretsmanager_secret_rotation": secretsmanager.ResourceSecretRotation(),
 			"aws_secretsmanager_secret_version":  secretsmanager.ResourceSecretVersion(),
@@ -1900,14 +1916,18 @@ func Provider() *schema.Provider {
 
 			"aws_simpledb_domain": simpledb.ResourceDomain(),
 
+			"aws_sns_message":              sns.ResourceMessage(),
+			"aws_sns_message_batch":        sns.ResourceMessageBatch(),
 			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
 			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
 			"aws_sns_topic":                sns.ResourceTopic(),
 			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(),
 			"aws_sns_topic_subscription":   sns.ResourceTopicSubscription(),
 
-			"aws_sqs_queue":        sqs.ResourceQueue(),
-			"aws_sqs_queue_policy": sqs.ResourceQueuePolicy(),
+			"aws_sqs_message":       sqs.ResourceMessage(),
+			"aws_sqs_message_batch": sqs.ResourceMessageBatch(),
+			"aws_sqs_queue":         sqs.ResourceQueue(),
+			"aws_sqs_queue_policy":  sqs.ResourceQueuePolicy(),
 
 			"aws_ssm_activation":                ssm.ResourceActivation(),
 			"aws_ssm_association":               ssm.ResourceAssociation(),
@@ -1916,14 +1936,16 @@ func Provider() *schema.Provider {
 			"aws_ssm_maintenance_window_target": ssm.ResourceMaintenanceWindowTarget(),
 			"aws_ssm_maintenance_window_task":   ssm.ResourceMaintenanceWindowTask(),
 			"aws_ssm_parameter":                 ssm.ResourceParameter(),
+			"aws_ssm_parameters":                ssm.ResourceParameters(),
 			"aws_ssm_patch_baseline":            ssm.ResourcePatchBaseline(),
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 
-			"aws_ssoadmin_account_assignment":           ssoadmin.ResourceAccountAssignment(),
-			"aws_ssoadmin_managed_policy_attachment":    ssoadmin.ResourceManagedPolicyAttachment(),
-			"aws_ssoadmin_permission_set":               ssoadmin.ResourcePermissionSet(),
-			"aws_ssoadmin_permission_set_inline_policy": ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_account_assignment":             ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_managed_policy_attachment":      ssoadmin.ResourceManagedPolicyAttachment(),
+			"aws_ssoadmin_organizational_unit_assignment": ssoadmin.ResourceOrganizationalUnitAssignment(),
+			"aws_ssoadmin_permission_set":                 ssoadmin.ResourcePermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":   ssoadmin.ResourcePermissionSetInlinePolicy(),
 
 			"aws_storagegateway_cache":                   storagegateway.ResourceCache(),
 			"aws_storagegateway_cached_iscsi_volume":     storagegateway.ResourceCachediSCSIVolume(),
@@ -44,3 +44,11 @@ func reportSettingTemplate_Values() []string {
 		reportSettingTemplateRestoreJobReport,
 	}
 }
+
+const (
+	restoreJobResourceTypeDynamoDB = "DynamoDB"
+	restoreJobResourceTypeEBS      = "EBS"
+	restoreJobResourceTypeEFS      = "EFS"
+	restoreJobResourceTypeRDS      = "RDS"
+	restoreJobResourceTypeS3       = "S3"
+)
@@ -0,0 +1,128 @@
+package backup
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceRecoveryPoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRecoveryPointRead,
+
+		Schema: map[string]*schema.Schema{
+			"backup_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"backup_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encryption_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iam_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"recovery_point_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\-\_\.]{1,50}$`), "must be a valid resource type, e.g. EBS"),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRecoveryPointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	name := d.Get("backup_vault_name").(string)
+	input := &backup.ListRecoveryPointsByBackupVaultInput{
+		BackupVaultName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("resource_arn"); ok {
+		input.ByResourceArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ByResourceType = aws.String(v.(string))
+	}
+
+	recoveryPoints, err := FindRecoveryPointsByBackupVault(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading Backup Vault (%s) recovery points: %w", name, err)
+	}
+
+	// Only completed recovery points can be restored.
+	var recoveryPoint *backup.RecoveryPointByBackupVault
+
+	for _, v := range recoveryPoints {
+		if aws.StringValue(v.Status) != backup.RecoveryPointStatusCompleted {
+			continue
+		}
+
+		if recoveryPoint == nil || aws.TimeValue(v.CreationDate).After(aws.TimeValue(recoveryPoint.CreationDate)) {
+			recoveryPoint = v
+		}
+	}
+
+	if recoveryPoint == nil {
+		return fmt.Errorf("no completed Backup Recovery Point found in Backup Vault (%s) matching criteria; try different search", name)
+	}
+
+	d.SetId(aws.StringValue(recoveryPoint.RecoveryPointArn))
+	d.Set("backup_size_in_bytes", recoveryPoint.BackupSizeInBytes)
+	d.Set("backup_vault_name", recoveryPoint.BackupVaultName)
+	if recoveryPoint.CompletionDate != nil {
+		d.Set("completion_date", aws.TimeValue(recoveryPoint.CompletionDate).Format(time.RFC3339))
+	}
+	d.Set("creation_date", aws.TimeValue(recoveryPoint.CreationDate).Format(time.RFC3339))
+	d.Set("encryption_key_arn", recoveryPoint.EncryptionKeyArn)
+	d.Set("iam_role_arn", recoveryPoint.IamRoleArn)
+	d.Set("is_encrypted", recoveryPoint.IsEncrypted)
+	d.Set("recovery_point_arn", recoveryPoint.RecoveryPointArn)
+	d.Set("resource_arn", recoveryPoint.ResourceArn)
+	d.Set("resource_type", recoveryPoint.ResourceType)
+	d.Set("status", recoveryPoint.Status)
+
+	return nil
+}
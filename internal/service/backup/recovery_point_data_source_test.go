@@ -0,0 +1,57 @@
+package backup_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// EnvVarBackupVaultName is the name of a Backup Vault containing at least one completed EBS recovery point.
+	EnvVarBackupVaultName = "AWS_BACKUP_VAULT_NAME"
+
+	EnvVarBackupVaultNameMessageError = "Backup Vault with a completed EBS recovery point required for restore testing"
+)
+
+func TestAccBackupRecoveryPointDataSource_basic(t *testing.T) {
+	vaultName := conns.SkipIfEnvVarEmpty(t, EnvVarBackupVaultName, EnvVarBackupVaultNameMessageError)
+	dataSourceName := "data.aws_backup_recovery_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, backup.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRecoveryPointDataSourceConfig_resourceType(vaultName, "NONEXISTENT"),
+				ExpectError: regexp.MustCompile(`no completed Backup Recovery Point found`),
+			},
+			{
+				Config: testAccRecoveryPointDataSourceConfig_resourceType(vaultName, "EBS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "backup_vault_name", vaultName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "creation_date"),
+					acctest.MatchResourceAttrRegionalARNNoAccount(dataSourceName, "recovery_point_arn", "ec2", regexp.MustCompile(`snapshot/snap-.+`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", dataSourceName, "recovery_point_arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resource_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_type", "EBS"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", backup.RecoveryPointStatusCompleted),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecoveryPointDataSourceConfig_resourceType(vaultName, resourceType string) string {
+	return fmt.Sprintf(`
+data "aws_backup_recovery_point" "test" {
+  backup_vault_name = %[1]q
+  resource_type     = %[2]q
+}
+`, vaultName, resourceType)
+}
@@ -0,0 +1,651 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfefs "github.com/hashicorp/terraform-provider-aws/internal/service/efs"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var restoreJobResourceTypeBlocks = []string{"dynamodb", "ebs", "efs", "rds", "s3"}
+
+func ResourceRestoreJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRestoreJobCreate,
+		Read:   resourceRestoreJobRead,
+		Update: schema.Noop,
+		Delete: resourceRestoreJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"backup_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_resource_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_restored_resource": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"dynamodb": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: restoreJobResourceTypeConflicts("dynamodb"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"target_table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"ebs": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: restoreJobResourceTypeConflicts("ebs"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"encrypted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"volume_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"volume_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ec2.VolumeType_Values(), false),
+						},
+					},
+				},
+			},
+			"efs": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: restoreJobResourceTypeConflicts("efs"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encrypted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"file_system_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"items_to_restore": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"new_file_system": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"performance_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(efs.PerformanceMode_Values(), false),
+						},
+					},
+				},
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rds": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: restoreJobResourceTypeConflicts("rds"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"db_instance_class": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"db_instance_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"db_subnet_group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"multi_az": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"publicly_accessible": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"recovery_point_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"s3": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: restoreJobResourceTypeConflicts("s3"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_bucket_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"new_bucket": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRestoreJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	resourceType, metadata := expandRestoreJobMetadata(d)
+	recoveryPointARN := d.Get("recovery_point_arn").(string)
+	input := &backup.StartRestoreJobInput{
+		IamRoleArn:       aws.String(d.Get("iam_role_arn").(string)),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Metadata:         aws.StringMap(metadata),
+		RecoveryPointArn: aws.String(recoveryPointARN),
+	}
+
+	if resourceType != "" {
+		input.ResourceType = aws.String(resourceType)
+	}
+
+	log.Printf("[DEBUG] Starting Backup Restore Job: %s", input)
+	output, err := conn.StartRestoreJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting Backup Restore Job (%s): %w", recoveryPointARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.RestoreJobId))
+
+	if _, err := waitRestoreJobCompleted(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Backup Restore Job (%s) to complete: %w", d.Id(), err)
+	}
+
+	return resourceRestoreJobRead(d, meta)
+}
+
+func resourceRestoreJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	output, err := FindRestoreJobByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Restore Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Backup Restore Job (%s): %w", d.Id(), err)
+	}
+
+	d.Set("backup_size_in_bytes", output.BackupSizeInBytes)
+	if output.CompletionDate != nil {
+		d.Set("completion_date", aws.TimeValue(output.CompletionDate).Format(time.RFC3339))
+	} else {
+		d.Set("completion_date", nil)
+	}
+	d.Set("created_resource_arn", output.CreatedResourceArn)
+	if output.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("iam_role_arn", output.IamRoleArn)
+	d.Set("recovery_point_arn", output.RecoveryPointArn)
+	d.Set("resource_type", output.ResourceType)
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+
+	return nil
+}
+
+func resourceRestoreJobDelete(d *schema.ResourceData, meta interface{}) error {
+	// Restore jobs cannot be deleted, only the resources that they restored.
+	if !d.Get("delete_restored_resource").(bool) {
+		return nil
+	}
+
+	createdResourceARN := d.Get("created_resource_arn").(string)
+
+	if createdResourceARN == "" {
+		return nil
+	}
+
+	_, metadata := expandRestoreJobMetadata(d)
+
+	if err := deleteRestoredResource(meta.(*conns.AWSClient), createdResourceARN, metadata, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error deleting Backup Restore Job (%s) restored resource (%s): %w", d.Id(), createdResourceARN, err)
+	}
+
+	return nil
+}
+
+func restoreJobResourceTypeConflicts(block string) []string {
+	var conflicts []string
+
+	for _, v := range restoreJobResourceTypeBlocks {
+		if v != block {
+			conflicts = append(conflicts, v)
+		}
+	}
+
+	return conflicts
+}
+
+// expandRestoreJobMetadata returns the restore job resource type and metadata from the resource type-specific blocks.
+// Keys in the metadata argument take precedence over those derived from the blocks.
+func expandRestoreJobMetadata(d *schema.ResourceData) (string, map[string]string) {
+	var resourceType string
+	metadata := make(map[string]string)
+
+	if v, ok := d.GetOk("dynamodb"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		resourceType = restoreJobResourceTypeDynamoDB
+
+		metadata["targetTableName"] = tfMap["target_table_name"].(string)
+
+		if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+			metadata["encryptionType"] = "KMS"
+			metadata["kmsMasterKeyArn"] = v
+		} else {
+			metadata["encryptionType"] = "Default"
+		}
+	}
+
+	if v, ok := d.GetOk("ebs"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		resourceType = restoreJobResourceTypeEBS
+
+		metadata["availabilityZone"] = tfMap["availability_zone"].(string)
+
+		if v, ok := tfMap["encrypted"].(bool); ok && v {
+			metadata["encrypted"] = strconv.FormatBool(v)
+		}
+
+		if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+			metadata["kmsKeyId"] = v
+		}
+
+		if v, ok := tfMap["volume_size"].(int); ok && v != 0 {
+			metadata["volumeSize"] = strconv.Itoa(v)
+		}
+
+		if v, ok := tfMap["volume_type"].(string); ok && v != "" {
+			metadata["volumeType"] = v
+		}
+	}
+
+	if v, ok := d.GetOk("efs"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		resourceType = restoreJobResourceTypeEFS
+		newFileSystem := tfMap["new_file_system"].(bool)
+
+		metadata["file-system-id"] = tfMap["file_system_id"].(string)
+		metadata["newFileSystem"] = strconv.FormatBool(newFileSystem)
+
+		if newFileSystem {
+			// A creation token is required to create a file system.
+			metadata["CreationToken"] = resource.UniqueId()
+		}
+
+		if v, ok := tfMap["encrypted"].(bool); ok && v {
+			metadata["Encrypted"] = strconv.FormatBool(v)
+		}
+
+		if v, ok := tfMap["items_to_restore"].(*schema.Set); ok && v.Len() > 0 {
+			items := make([]string, 0, v.Len())
+
+			for _, item := range v.List() {
+				items = append(items, item.(string))
+			}
+
+			// The items to restore are a JSON-encoded list of paths.
+			b, _ := json.Marshal(items)
+			metadata["itemsToRestore"] = string(b)
+		}
+
+		if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+			metadata["KmsKeyId"] = v
+		}
+
+		if v, ok := tfMap["performance_mode"].(string); ok && v != "" {
+			metadata["PerformanceMode"] = v
+		}
+	}
+
+	if v, ok := d.GetOk("rds"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		resourceType = restoreJobResourceTypeRDS
+
+		metadata["DBInstanceIdentifier"] = tfMap["db_instance_identifier"].(string)
+
+		if v, ok := tfMap["availability_zone"].(string); ok && v != "" {
+			metadata["AvailabilityZone"] = v
+		}
+
+		if v, ok := tfMap["db_instance_class"].(string); ok && v != "" {
+			metadata["DBInstanceClass"] = v
+		}
+
+		if v, ok := tfMap["db_subnet_group_name"].(string); ok && v != "" {
+			metadata["DBSubnetGroupName"] = v
+		}
+
+		if v, ok := tfMap["multi_az"].(bool); ok && v {
+			metadata["MultiAZ"] = strconv.FormatBool(v)
+		}
+
+		if v, ok := tfMap["port"].(int); ok && v != 0 {
+			metadata["Port"] = strconv.Itoa(v)
+		}
+
+		if v, ok := tfMap["publicly_accessible"].(bool); ok && v {
+			metadata["PubliclyAccessible"] = strconv.FormatBool(v)
+		}
+	}
+
+	if v, ok := d.GetOk("s3"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		resourceType = restoreJobResourceTypeS3
+		newBucket := tfMap["new_bucket"].(bool)
+
+		metadata["DestinationBucketName"] = tfMap["destination_bucket_name"].(string)
+		metadata["NewBucket"] = strconv.FormatBool(newBucket)
+
+		if newBucket {
+			metadata["CreationToken"] = resource.UniqueId()
+		}
+
+		if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+			metadata["Encrypted"] = "true"
+			metadata["EncryptionType"] = "SSE-KMS"
+			metadata["KMSKey"] = v
+		}
+	}
+
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		metadata[k] = v.(string)
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		resourceType = v.(string)
+	}
+
+	return resourceType, metadata
+}
+
+// deleteRestoredResource deletes a resource created by a restore job.
+// File systems and buckets are only deleted if the restore job created them, rather than restoring into existing ones.
+func deleteRestoredResource(client *conns.AWSClient, createdResourceARN string, metadata map[string]string, timeout time.Duration) error {
+	parsedARN, err := arn.Parse(createdResourceARN)
+
+	if err != nil {
+		return err
+	}
+
+	switch service, resourceID := parsedARN.Service, parsedARN.Resource; {
+	case service == ec2.ServiceName && strings.HasPrefix(resourceID, "volume/"):
+		conn := client.EC2Conn
+		volumeID := strings.TrimPrefix(resourceID, "volume/")
+
+		log.Printf("[DEBUG] Deleting EBS Volume: %s", volumeID)
+		_, err := conn.DeleteVolume(&ec2.DeleteVolumeInput{
+			VolumeId: aws.String(volumeID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidVolumeNotFound) {
+			return nil
+		}
+
+		return err
+
+	case service == rds.ServiceName && strings.HasPrefix(resourceID, "db:"):
+		conn := client.RDSConn
+		dbInstanceID := strings.TrimPrefix(resourceID, "db:")
+
+		log.Printf("[DEBUG] Deleting RDS DB Instance: %s", dbInstanceID)
+		_, err := conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier:   aws.String(dbInstanceID),
+			DeleteAutomatedBackups: aws.Bool(true),
+			SkipFinalSnapshot:      aws.Bool(true),
+		})
+
+		if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfresource.RetryUntilNotFound(timeout, func() (interface{}, error) {
+			return tfrds.FindDBInstanceByID(conn, dbInstanceID)
+		})
+
+		return err
+
+	case service == efs.ServiceName && strings.HasPrefix(resourceID, "file-system/"):
+		if metadata["newFileSystem"] != "true" {
+			log.Printf("[WARN] Not deleting EFS File System (%s), which was not created by the restore job", createdResourceARN)
+			return nil
+		}
+
+		conn := client.EFSConn
+		fileSystemID := strings.TrimPrefix(resourceID, "file-system/")
+
+		log.Printf("[DEBUG] Deleting EFS File System: %s", fileSystemID)
+		_, err := conn.DeleteFileSystem(&efs.DeleteFileSystemInput{
+			FileSystemId: aws.String(fileSystemID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, efs.ErrCodeFileSystemNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfresource.RetryUntilNotFound(timeout, func() (interface{}, error) {
+			return tfefs.FindFileSystemByID(conn, fileSystemID)
+		})
+
+		return err
+
+	case service == dynamodb.ServiceName && strings.HasPrefix(resourceID, "table/"):
+		conn := client.DynamoDBConn
+		tableName := strings.TrimPrefix(resourceID, "table/")
+
+		log.Printf("[DEBUG] Deleting DynamoDB Table: %s", tableName)
+		_, err := conn.DeleteTable(&dynamodb.DeleteTableInput{
+			TableName: aws.String(tableName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		return err
+
+	case service == s3.ServiceName:
+		if metadata["NewBucket"] != "true" {
+			log.Printf("[WARN] Not deleting S3 Bucket (%s), which was not created by the restore job", createdResourceARN)
+			return nil
+		}
+
+		conn := client.S3Conn
+
+		if _, err := tfs3.DeleteAllObjectVersions(conn, resourceID, "", false, false); err != nil {
+			if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+				return nil
+			}
+
+			return err
+		}
+
+		log.Printf("[DEBUG] Deleting S3 Bucket: %s", resourceID)
+		_, err := conn.DeleteBucket(&s3.DeleteBucketInput{
+			Bucket: aws.String(resourceID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return nil
+		}
+
+		return err
+	}
+
+	log.Printf("[WARN] Deleting restored resource (%s) is not supported", createdResourceARN)
+
+	return nil
+}
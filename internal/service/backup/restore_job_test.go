@@ -0,0 +1,136 @@
+package backup_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbackup "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBackupRestoreJob_ebs(t *testing.T) {
+	var restoreJob backup.DescribeRestoreJobOutput
+	vaultName := conns.SkipIfEnvVarEmpty(t, EnvVarBackupVaultName, EnvVarBackupVaultNameMessageError)
+	resourceName := "aws_backup_restore_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, backup.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRestoreJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreJobConfig_ebs(vaultName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreJobExists(resourceName, &restoreJob),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "created_resource_arn", "ec2", regexp.MustCompile(`volume/vol-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "completion_date"),
+					resource.TestCheckResourceAttr(resourceName, "delete_restored_resource", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ebs.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "recovery_point_arn", "data.aws_backup_recovery_point.test", "recovery_point_arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "EBS"),
+					resource.TestCheckResourceAttr(resourceName, "status", backup.RestoreJobStatusCompleted),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_restored_resource", "ebs"},
+			},
+		},
+	})
+}
+
+func testAccCheckRestoreJobDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_backup_restore_job" {
+			continue
+		}
+
+		volumeID := rs.Primary.Attributes["created_resource_arn"]
+		volumeID = volumeID[strings.LastIndex(volumeID, "/")+1:]
+
+		volume, err := tfec2.FindEBSVolume(conn, &ec2.DescribeVolumesInput{
+			VolumeIds: aws.StringSlice([]string{volumeID}),
+		})
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if state := aws.StringValue(volume.State); state != ec2.VolumeStateDeleting && state != ec2.VolumeStateDeleted {
+			return fmt.Errorf("Backup Restore Job (%s) restored EBS Volume (%s) still exists", rs.Primary.ID, volumeID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRestoreJobExists(resourceName string, v *backup.DescribeRestoreJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Backup Restore Job ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+
+		output, err := tfbackup.FindRestoreJobByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRestoreJobConfig_ebs(vaultName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_backup_recovery_point" "test" {
+  backup_vault_name = %[1]q
+  resource_type     = "EBS"
+}
+
+resource "aws_backup_restore_job" "test" {
+  recovery_point_arn       = data.aws_backup_recovery_point.test.recovery_point_arn
+  iam_role_arn             = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/service-role/AWSBackupDefaultServiceRole"
+  delete_restored_resource = true
+
+  ebs {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    volume_type       = "gp3"
+  }
+}
+`, vaultName))
+}
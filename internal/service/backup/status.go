@@ -5,6 +5,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func statusFramework(conn *backup.Backup, id string) resource.StateRefreshFunc {
@@ -26,3 +27,19 @@ func statusFramework(conn *backup.Backup, id string) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.DeploymentStatus), nil
 	}
 }
+
+func statusRestoreJob(conn *backup.Backup, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindRestoreJobByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -1,10 +1,13 @@
 package backup
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -62,3 +65,25 @@ func waitFrameworkDeleted(conn *backup.Backup, id string, timeout time.Duration)
 
 	return nil, err
 }
+
+func waitRestoreJobCompleted(conn *backup.Backup, id string, timeout time.Duration) (*backup.DescribeRestoreJobOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{backup.RestoreJobStatusPending, backup.RestoreJobStatusRunning},
+		Target:     []string{backup.RestoreJobStatusCompleted},
+		Refresh:    statusRestoreJob(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*backup.DescribeRestoreJobOutput); ok {
+		if status := aws.StringValue(output.Status); status == backup.RestoreJobStatusAborted || status == backup.RestoreJobStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
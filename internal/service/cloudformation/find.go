@@ -36,6 +36,91 @@ func FindChangeSetByStackIDAndChangeSetName(conn *cloudformation.CloudFormation,
 	return output, nil
 }
 
+// FindChangeSetChangesByStackIDAndChangeSetName returns the changes of a change set, across all pages.
+func FindChangeSetChangesByStackIDAndChangeSetName(conn *cloudformation.CloudFormation, stackID, changeSetName string) ([]*cloudformation.Change, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	}
+	var changes []*cloudformation.Change
+
+	for {
+		output, err := conn.DescribeChangeSet(input)
+
+		if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeChangeSetNotFoundException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		changes = append(changes, output.Changes...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return changes, nil
+}
+
+func FindStackDriftDetectionStatusByID(conn *cloudformation.CloudFormation, id string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := &cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(id),
+	}
+
+	output, err := conn.DescribeStackDriftDetectionStatus(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// FindStackResourceDriftsByStackID returns the stack resources that have been modified or deleted
+// outside of CloudFormation, as of the most recent drift detection.
+func FindStackResourceDriftsByStackID(conn *cloudformation.CloudFormation, stackID string) ([]*cloudformation.StackResourceDrift, error) {
+	input := &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: aws.String(stackID),
+		StackResourceDriftStatusFilters: aws.StringSlice([]string{
+			cloudformation.StackResourceDriftStatusDeleted,
+			cloudformation.StackResourceDriftStatusModified,
+		}),
+	}
+	var drifts []*cloudformation.StackResourceDrift
+
+	err := conn.DescribeStackResourceDriftsPages(input, func(page *cloudformation.DescribeStackResourceDriftsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		drifts = append(drifts, page.StackResourceDrifts...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return drifts, nil
+}
+
 func FindStackByID(conn *cloudformation.CloudFormation, id string) (*cloudformation.Stack, error) {
 	input := &cloudformation.DescribeStacksInput{
 		StackName: aws.String(id),
@@ -47,3 +47,44 @@ func flattenParameters(cfParams []*cloudformation.Parameter,
 	}
 	return params
 }
+
+func flattenChanges(apiObjects []*cloudformation.Change) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.ResourceChange == nil {
+			continue
+		}
+
+		rc := apiObject.ResourceChange
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":               aws.StringValue(rc.Action),
+			"logical_resource_id":  aws.StringValue(rc.LogicalResourceId),
+			"physical_resource_id": aws.StringValue(rc.PhysicalResourceId),
+			"replacement":          aws.StringValue(rc.Replacement),
+			"resource_type":        aws.StringValue(rc.ResourceType),
+		})
+	}
+
+	return tfList
+}
+
+func flattenStackResourceDrifts(apiObjects []*cloudformation.StackResourceDrift) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"logical_resource_id":         aws.StringValue(apiObject.LogicalResourceId),
+			"physical_resource_id":        aws.StringValue(apiObject.PhysicalResourceId),
+			"resource_type":               aws.StringValue(apiObject.ResourceType),
+			"stack_resource_drift_status": aws.StringValue(apiObject.StackResourceDriftStatus),
+		})
+	}
+
+	return tfList
+}
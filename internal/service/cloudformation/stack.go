@@ -1,12 +1,15 @@
 package cloudformation
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -118,12 +121,94 @@ func ResourceStack() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"preview_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"require_no_replacement": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"planned_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"detect_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drifted_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_resource_drift_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceStackPreviewChanges,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// stackChangeSetArguments are the arguments whose changes are applied to the stack with a change set.
+var stackChangeSetArguments = []string{
+	"capabilities",
+	"iam_role_arn",
+	"notification_arns",
+	"parameters",
+	"tags",
+	"template_body",
+	"template_url",
+}
+
 func resourceStackCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudFormationConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -312,11 +397,28 @@ func resourceStackRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.Get("detect_drift").(bool) {
+		if err := readStackDrift(conn, d, stack); err != nil {
+			return fmt.Errorf("error detecting CloudFormation Stack (%s) drift: %w", d.Id(), err)
+		}
+	} else {
+		d.Set("drift_status", nil)
+		d.Set("drifted_resources", nil)
+	}
+
 	return nil
 }
 
 func resourceStackUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudFormationConn
+
+	if d.Get("preview_changes").(bool) || d.Get("require_no_replacement").(bool) {
+		if err := updateStackWithChangeSet(conn, d, meta); err != nil {
+			return err
+		}
+
+		return resourceStackRead(d, meta)
+	}
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -424,3 +526,287 @@ func resourceStackDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// stackConfig is implemented by both schema.ResourceData and schema.ResourceDiff.
+type stackConfig interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+	Id() string
+}
+
+func expandStackChangeSetInput(d stackConfig, meta interface{}, changeSetName string) (*cloudformation.CreateChangeSetInput, error) {
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		ChangeSetType: aws.String(cloudformation.ChangeSetTypeUpdate),
+		StackName:     aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return nil, fmt.Errorf("template body contains an invalid JSON or YAML: %s", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+	if input.TemplateURL == nil && input.TemplateBody == nil {
+		input.UsePreviousTemplate = aws.Bool(true)
+	}
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = flex.ExpandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("notification_arns"); ok {
+		input.NotificationARNs = flex.ExpandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+	}
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	return input, nil
+}
+
+// createStackChangeSet creates a change set and waits for it to be ready to execute, returning its changes.
+// If the change set contains no changes it is deleted and nil is returned.
+func createStackChangeSet(conn *cloudformation.CloudFormation, input *cloudformation.CreateChangeSetInput) ([]*cloudformation.Change, error) {
+	stackID, changeSetName := aws.StringValue(input.StackName), aws.StringValue(input.ChangeSetName)
+
+	log.Printf("[DEBUG] Creating CloudFormation Change Set: %s", input)
+	_, err := tfresource.RetryWhen(tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateChangeSet(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, ErrCodeValidationError, "is invalid or cannot be assumed") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating CloudFormation Stack (%s) Change Set (%s): %w", stackID, changeSetName, err)
+	}
+
+	output, err := WaitChangeSetCreated(conn, stackID, changeSetName)
+
+	if output != nil && aws.StringValue(output.Status) == cloudformation.ChangeSetStatusFailed && isNoChangesChangeSetReason(aws.StringValue(output.StatusReason)) {
+		deleteStackChangeSet(conn, stackID, changeSetName)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		deleteStackChangeSet(conn, stackID, changeSetName)
+
+		return nil, fmt.Errorf("error waiting for CloudFormation Stack (%s) Change Set (%s) create: %w", stackID, changeSetName, err)
+	}
+
+	changes, err := FindChangeSetChangesByStackIDAndChangeSetName(conn, stackID, changeSetName)
+
+	if err != nil {
+		deleteStackChangeSet(conn, stackID, changeSetName)
+
+		return nil, fmt.Errorf("error reading CloudFormation Stack (%s) Change Set (%s): %w", stackID, changeSetName, err)
+	}
+
+	for _, change := range changes {
+		if rc := change.ResourceChange; rc != nil {
+			log.Printf("[INFO] CloudFormation Stack (%s) Change Set (%s): %s %s (%s), replacement: %s", stackID, changeSetName, aws.StringValue(rc.Action), aws.StringValue(rc.LogicalResourceId), aws.StringValue(rc.ResourceType), aws.StringValue(rc.Replacement))
+		}
+	}
+
+	return changes, nil
+}
+
+func deleteStackChangeSet(conn *cloudformation.CloudFormation, stackID, changeSetName string) {
+	_, err := conn.DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeChangeSetNotFoundException) {
+		log.Printf("[WARN] Error deleting CloudFormation Stack (%s) Change Set (%s): %s", stackID, changeSetName, err)
+	}
+}
+
+func isNoChangesChangeSetReason(reason string) bool {
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
+
+// ChangeSetReplacements returns the logical IDs of the resources that a change set replaces.
+func ChangeSetReplacements(changes []*cloudformation.Change) []string {
+	var replacements []string
+
+	for _, change := range changes {
+		if change == nil || change.ResourceChange == nil {
+			continue
+		}
+
+		if aws.StringValue(change.ResourceChange.Replacement) == cloudformation.ReplacementTrue {
+			replacements = append(replacements, aws.StringValue(change.ResourceChange.LogicalResourceId))
+		}
+	}
+
+	return replacements
+}
+
+// resourceStackPreviewChanges creates a change set for a planned update of the stack and plans
+// planned_changes from its resource changes, failing the plan if require_no_replacement is set and
+// any resource would be replaced. The change set is deleted once it has been described.
+func resourceStackPreviewChanges(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("preview_changes").(bool) || !diff.HasChanges(stackChangeSetArguments...) {
+		return nil
+	}
+
+	for _, k := range stackChangeSetArguments {
+		if !diff.NewValueKnown(k) {
+			return diff.SetNewComputed("planned_changes")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+	changeSetName := "terraform-preview-" + resource.UniqueId()
+
+	input, err := expandStackChangeSetInput(diff, meta, changeSetName)
+
+	if err != nil {
+		return err
+	}
+
+	changes, err := createStackChangeSet(conn, input)
+
+	if err != nil {
+		return err
+	}
+
+	if changes != nil {
+		deleteStackChangeSet(conn, diff.Id(), changeSetName)
+	}
+
+	if v := ChangeSetReplacements(changes); diff.Get("require_no_replacement").(bool) && len(v) > 0 {
+		return fmt.Errorf("CloudFormation Stack (%s) update would replace resources: %s", diff.Id(), strings.Join(v, ", "))
+	}
+
+	return diff.SetNew("planned_changes", flattenChanges(changes))
+}
+
+func updateStackWithChangeSet(conn *cloudformation.CloudFormation, d *schema.ResourceData, meta interface{}) error {
+	changeSetName := "terraform-" + resource.UniqueId()
+
+	input, err := expandStackChangeSetInput(d, meta, changeSetName)
+
+	if err != nil {
+		return err
+	}
+
+	changes, err := createStackChangeSet(conn, input)
+
+	if err != nil {
+		return err
+	}
+
+	if changes != nil {
+		if v := ChangeSetReplacements(changes); d.Get("require_no_replacement").(bool) && len(v) > 0 {
+			deleteStackChangeSet(conn, d.Id(), changeSetName)
+
+			return fmt.Errorf("CloudFormation Stack (%s) update would replace resources: %s", d.Id(), strings.Join(v, ", "))
+		}
+
+		requestToken := resource.UniqueId()
+
+		log.Printf("[DEBUG] Executing CloudFormation Stack (%s) Change Set (%s)", d.Id(), changeSetName)
+		_, err := conn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+			ChangeSetName:      aws.String(changeSetName),
+			ClientRequestToken: aws.String(requestToken),
+			StackName:          aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error executing CloudFormation Stack (%s) Change Set (%s): %w", d.Id(), changeSetName, err)
+		}
+
+		if _, err := WaitStackUpdated(conn, d.Id(), requestToken, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for CloudFormation Stack (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("planned_changes", flattenChanges(changes)); err != nil {
+		return fmt.Errorf("error setting planned_changes: %w", err)
+	}
+
+	if d.HasChanges("policy_body", "policy_url") {
+		input := &cloudformation.SetStackPolicyInput{
+			StackName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("policy_body"); ok {
+			policy, err := structure.NormalizeJsonString(v)
+			if err != nil {
+				return fmt.Errorf("policy body contains an invalid JSON: %s", err)
+			}
+			input.StackPolicyBody = aws.String(policy)
+		} else if v, ok := d.GetOk("policy_url"); ok {
+			input.StackPolicyURL = aws.String(v.(string))
+		}
+
+		if input.StackPolicyBody != nil || input.StackPolicyURL != nil {
+			if _, err := conn.SetStackPolicy(input); err != nil {
+				return fmt.Errorf("error setting CloudFormation Stack (%s) policy: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func readStackDrift(conn *cloudformation.CloudFormation, d *schema.ResourceData, stack *cloudformation.Stack) error {
+	// Drift detection is only possible for stacks in a stable state.
+	if status := aws.StringValue(stack.StackStatus); strings.HasSuffix(status, "_IN_PROGRESS") {
+		log.Printf("[DEBUG] Skipping CloudFormation Stack (%s) drift detection, stack status is %s", d.Id(), status)
+		return nil
+	}
+
+	output, err := conn.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	detection, err := WaitStackDriftDetectionComplete(conn, aws.StringValue(output.StackDriftDetectionId))
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(detection.DetectionStatus) == cloudformation.StackDriftDetectionStatusDetectionFailed {
+		log.Printf("[WARN] CloudFormation Stack (%s) drift detection did not check all resources: %s", d.Id(), aws.StringValue(detection.DetectionStatusReason))
+	}
+
+	drifts, err := FindStackResourceDriftsByStackID(conn, d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("drift_status", detection.StackDriftStatus)
+
+	if err := d.Set("drifted_resources", flattenStackResourceDrifts(drifts)); err != nil {
+		return fmt.Errorf("error setting drifted_resources: %w", err)
+	}
+
+	return nil
+}
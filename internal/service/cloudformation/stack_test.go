@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -444,6 +445,112 @@ func TestAccCloudFormationStack_onFailure(t *testing.T) {
 	})
 }
 
+func TestChangeSetReplacements(t *testing.T) {
+	changes := []*cloudformation.Change{
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionModify),
+				LogicalResourceId: aws.String("Vpc"),
+				Replacement:       aws.String(cloudformation.ReplacementTrue),
+			},
+		},
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionModify),
+				LogicalResourceId: aws.String("Subnet"),
+				Replacement:       aws.String(cloudformation.ReplacementConditional),
+			},
+		},
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionAdd),
+				LogicalResourceId: aws.String("RouteTable"),
+			},
+		},
+		{},
+	}
+
+	got := tfcloudformation.ChangeSetReplacements(changes)
+
+	if len(got) != 1 || got[0] != "Vpc" {
+		t.Errorf("expected [Vpc], got %v", got)
+	}
+}
+
+func TestAccCloudFormationStack_previewChanges(t *testing.T) {
+	var stack cloudformation.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_previewChanges(rName, "10.0.0.0/16", "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "preview_changes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "require_no_replacement", "true"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+				),
+			},
+			{
+				Config: testAccStackConfig_previewChanges(rName, "10.0.0.0/16", "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcName", "two"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.action", cloudformation.ChangeActionModify),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.replacement", cloudformation.ReplacementFalse),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.resource_type", "AWS::EC2::VPC"),
+				),
+			},
+			{
+				Config:      testAccStackConfig_previewChanges(rName, "10.1.0.0/16", "two"),
+				ExpectError: regexp.MustCompile(`update would replace resources: MyVPC`),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStack_detectDrift(t *testing.T) {
+	var stack cloudformation.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_detectDrift(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "drift_status", cloudformation.StackDriftStatusInSync),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.#", "0"),
+					testAccCheckCloudFormationStackVPCTagged(resourceName, "Name", "drifted"),
+				),
+			},
+			{
+				Config: testAccStackConfig_detectDrift(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drift_status", cloudformation.StackDriftStatusDrifted),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.0.resource_type", "AWS::EC2::VPC"),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.0.stack_resource_drift_status", cloudformation.StackResourceDriftStatusModified),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -520,6 +627,29 @@ func testAccCheckCloudFormationStackDisappears(stack *cloudformation.Stack) reso
 	}
 }
 
+func testAccCheckCloudFormationStackVPCTagged(n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: aws.StringSlice([]string{rs.Primary.Attributes["outputs.VpcID"]}),
+			Tags: []*ec2.Tag{
+				{
+					Key:   aws.String(key),
+					Value: aws.String(value),
+				},
+			},
+		})
+
+		return err
+	}
+}
+
 func testAccStackConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudformation_stack" "test" {
@@ -1033,3 +1163,73 @@ resource "aws_cloudformation_stack" "test" {
 }
 `, rName)
 }
+
+func testAccStackConfig_previewChanges(rName, cidr, vpcName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  parameters = {
+    VpcCIDR = %[2]q
+    VpcName = %[3]q
+  }
+
+  preview_changes        = true
+  require_no_replacement = true
+
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Type" : "String"
+    },
+    "VpcName" : {
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"},
+        "Tags" : [
+          {"Key": "Name", "Value": {"Ref": "VpcName"}}
+        ]
+      }
+    }
+  }
+}
+STACK
+}
+`, rName, cidr, vpcName)
+}
+
+func testAccStackConfig_detectDrift(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name         = %[1]q
+  detect_drift = true
+
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : "10.0.0.0/16",
+        "Tags" : [
+          {"Key": "Name", "Value": %[1]q}
+        ]
+      }
+    }
+  },
+  "Outputs" : {
+    "VpcID" : {
+      "Value" : { "Ref" : "MyVPC" }
+    }
+  }
+}
+STACK
+}
+`, rName)
+}
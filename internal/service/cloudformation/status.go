@@ -41,6 +41,18 @@ func StatusStackSetOperation(conn *cloudformation.CloudFormation, stackSetName,
 	}
 }
 
+func StatusStackDriftDetection(conn *cloudformation.CloudFormation, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindStackDriftDetectionStatusByID(conn, id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectionStatus), nil
+	}
+}
+
 const (
 	stackStatusError    = "Error"
 	stackStatusNotFound = "NotFound"
@@ -38,6 +38,30 @@ func WaitChangeSetCreated(conn *cloudformation.CloudFormation, stackID, changeSe
 	return nil, err
 }
 
+const (
+	StackDriftDetectionTimeout = 5 * time.Minute
+
+	stackDriftDetectionDelay = 2 * time.Second
+)
+
+func WaitStackDriftDetectionComplete(conn *cloudformation.CloudFormation, id string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudformation.StackDriftDetectionStatusDetectionInProgress},
+		Target:  []string{cloudformation.StackDriftDetectionStatusDetectionComplete, cloudformation.StackDriftDetectionStatusDetectionFailed},
+		Refresh: StatusStackDriftDetection(conn, id),
+		Timeout: StackDriftDetectionTimeout,
+		Delay:   stackDriftDetectionDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	// Default maximum amount of time to wait for a StackSetInstance to be Created
 	StackSetInstanceCreatedDefaultTimeout = 30 * time.Minute
@@ -1,16 +1,21 @@
 package cloudfront
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceFunction() *schema.Resource {
@@ -63,7 +68,62 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"test_event": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_object": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+						},
+						"expected_headers": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expected_status_code": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(100, 599),
+						},
+						"expected_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"test_event_result": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_utilization": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"function_error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"function_execution_logs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"function_output": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
+
+		CustomizeDiff: resourceFunctionCustomizeDiff,
 	}
 }
 
@@ -89,6 +149,25 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(output.FunctionSummary.Name))
 
+	tfList := d.Get("test_event").([]interface{})
+	results, err := testFunction(conn, d.Id(), aws.StringValue(output.ETag), tfList)
+
+	if err != nil {
+		return err
+	}
+
+	if failures := checkFunctionTestEvents(tfList, results); failures != nil {
+		if d.Get("publish").(bool) {
+			return fmt.Errorf("error testing CloudFront Function (%s), not publishing: %w", d.Id(), failures)
+		}
+
+		log.Printf("[WARN] CloudFront Function (%s) test events failed: %s", d.Id(), failures)
+	}
+
+	if err := d.Set("test_event_result", flattenTestResults(results)); err != nil {
+		return fmt.Errorf("error setting test_event_result: %w", err)
+	}
+
 	if d.Get("publish").(bool) {
 		input := &cloudfront.PublishFunctionInput{
 			Name:    aws.String(d.Id()),
@@ -177,6 +256,50 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		etag = aws.StringValue(output.ETag)
 	}
 
+	if d.HasChanges("code", "publish", "runtime", "test_event") {
+		tfList := d.Get("test_event").([]interface{})
+		results, err := testFunction(conn, d.Id(), etag, tfList)
+
+		if err != nil {
+			return err
+		}
+
+		if failures := checkFunctionTestEvents(tfList, results); failures != nil {
+			if d.Get("publish").(bool) {
+				// Restore the previous DEVELOPMENT stage code and keep the previous state so that the update is retried on the next apply.
+				if d.HasChanges("code", "comment", "runtime") {
+					oldCode, _ := d.GetChange("code")
+					oldComment, _ := d.GetChange("comment")
+					oldRuntime, _ := d.GetChange("runtime")
+					input := &cloudfront.UpdateFunctionInput{
+						FunctionCode: []byte(oldCode.(string)),
+						FunctionConfig: &cloudfront.FunctionConfig{
+							Comment: aws.String(oldComment.(string)),
+							Runtime: aws.String(oldRuntime.(string)),
+						},
+						Name:    aws.String(d.Id()),
+						IfMatch: aws.String(etag),
+					}
+
+					log.Printf("[INFO] Reverting Cloudfront Function: %s", d.Id())
+					if _, err := conn.UpdateFunction(input); err != nil {
+						return fmt.Errorf("error reverting CloudFront Function (%s) after failed test events (%s): %w", d.Id(), failures, err)
+					}
+				}
+
+				d.Partial(true)
+
+				return fmt.Errorf("error testing CloudFront Function (%s), not publishing: %w", d.Id(), failures)
+			}
+
+			log.Printf("[WARN] CloudFront Function (%s) test events failed: %s", d.Id(), failures)
+		}
+
+		if err := d.Set("test_event_result", flattenTestResults(results)); err != nil {
+			return fmt.Errorf("error setting test_event_result: %w", err)
+		}
+	}
+
 	if d.Get("publish").(bool) {
 		input := &cloudfront.PublishFunctionInput{
 			Name:    aws.String(d.Id()),
@@ -213,3 +336,152 @@ func resourceFunctionDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func resourceFunctionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// Test events are run again whenever the tested code or the test events change.
+	if o, n := diff.GetChange("test_event"); len(o.([]interface{}))+len(n.([]interface{})) > 0 && diff.HasChanges("code", "publish", "runtime", "test_event") {
+		return diff.SetNewComputed("test_event_result")
+	}
+
+	return nil
+}
+
+// testFunction runs each test event against the function's DEVELOPMENT stage.
+func testFunction(conn *cloudfront.CloudFront, name, etag string, tfList []interface{}) ([]*cloudfront.TestResult, error) {
+	var results []*cloudfront.TestResult
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		input := &cloudfront.TestFunctionInput{
+			EventObject: []byte(tfMap["event_object"].(string)),
+			IfMatch:     aws.String(etag),
+			Name:        aws.String(name),
+			Stage:       aws.String(cloudfront.FunctionStageDevelopment),
+		}
+
+		log.Printf("[DEBUG] Testing CloudFront Function (%s) with test_event %d", name, i)
+		output, err := conn.TestFunction(input)
+
+		if err != nil {
+			return nil, fmt.Errorf("error testing CloudFront Function (%s) with test_event %d: %w", name, i, err)
+		}
+
+		result := output.TestResult
+
+		if result == nil {
+			result = &cloudfront.TestResult{}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// checkFunctionTestEvents returns an error describing every failed assertion of the test events.
+func checkFunctionTestEvents(tfList []interface{}, results []*cloudfront.TestResult) error {
+	var errs *multierror.Error
+
+	for i, result := range results {
+		tfMap, ok := tfList[i].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if err := checkFunctionTestEvent(tfMap, result); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("test_event %d: %w", i, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// checkFunctionTestEvent returns an error describing every expected output assertion of the test event that the test result does not satisfy.
+func checkFunctionTestEvent(tfMap map[string]interface{}, result *cloudfront.TestResult) error {
+	if v := aws.StringValue(result.FunctionErrorMessage); v != "" {
+		return fmt.Errorf("function error: %s", v)
+	}
+
+	type header struct {
+		Value string `json:"value"`
+	}
+
+	var output struct {
+		Request *struct {
+			Headers map[string]header `json:"headers"`
+			URI     string            `json:"uri"`
+		} `json:"request"`
+		Response *struct {
+			Headers    map[string]header `json:"headers"`
+			StatusCode int               `json:"statusCode"`
+		} `json:"response"`
+	}
+
+	if err := json.Unmarshal([]byte(aws.StringValue(result.FunctionOutput)), &output); err != nil {
+		return fmt.Errorf("error parsing function output: %w", err)
+	}
+
+	var errs *multierror.Error
+
+	if v, ok := tfMap["expected_status_code"].(int); ok && v != 0 {
+		if output.Response == nil {
+			errs = multierror.Append(errs, fmt.Errorf("expected status code %d, function returned a request", v))
+		} else if output.Response.StatusCode != v {
+			errs = multierror.Append(errs, fmt.Errorf("expected status code %d, got %d", v, output.Response.StatusCode))
+		}
+	}
+
+	if v, ok := tfMap["expected_uri"].(string); ok && v != "" {
+		if output.Request == nil {
+			errs = multierror.Append(errs, fmt.Errorf("expected URI %q, function returned a response", v))
+		} else if output.Request.URI != v {
+			errs = multierror.Append(errs, fmt.Errorf("expected URI %q, got %q", v, output.Request.URI))
+		}
+	}
+
+	if v, ok := tfMap["expected_headers"].(map[string]interface{}); ok && len(v) > 0 {
+		var headers map[string]header
+
+		if output.Response != nil {
+			headers = output.Response.Headers
+		} else if output.Request != nil {
+			headers = output.Request.Headers
+		}
+
+		for name, value := range v {
+			// CloudFront Functions header names are lowercase.
+			if got, ok := headers[strings.ToLower(name)]; !ok {
+				errs = multierror.Append(errs, fmt.Errorf("expected header %q, not found", name))
+			} else if got.Value != value.(string) {
+				errs = multierror.Append(errs, fmt.Errorf("expected header %q value %q, got %q", name, value, got.Value))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func flattenTestResults(apiObjects []*cloudfront.TestResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"compute_utilization":     aws.StringValue(apiObject.ComputeUtilization),
+			"function_error_message":  aws.StringValue(apiObject.FunctionErrorMessage),
+			"function_execution_logs": aws.StringValueSlice(apiObject.FunctionExecutionLogs),
+			"function_output":         aws.StringValue(apiObject.FunctionOutput),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,87 @@
+package cloudfront
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+func TestCheckFunctionTestEvent(t *testing.T) {
+	redirect := `{"response":{"headers":{"location":{"value":"https://aws.amazon.com/cloudfront/"}},"statusDescription":"Found","cookies":{},"statusCode":302}}`
+	rewrite := `{"request":{"headers":{"host":{"value":"www.example.com"}},"method":"GET","querystring":{},"uri":"/index.html","cookies":{}}}`
+
+	cases := []struct {
+		Name     string
+		TestMap  map[string]interface{}
+		Result   *cloudfront.TestResult
+		ErrCount int
+	}{
+		{
+			Name:    "no assertions",
+			TestMap: map[string]interface{}{},
+			Result:  &cloudfront.TestResult{FunctionOutput: aws.String(redirect)},
+		},
+		{
+			Name:     "function error",
+			TestMap:  map[string]interface{}{},
+			Result:   &cloudfront.TestResult{FunctionErrorMessage: aws.String("TypeError: cannot get property")},
+			ErrCount: 1,
+		},
+		{
+			Name: "response matches",
+			TestMap: map[string]interface{}{
+				"expected_status_code": 302,
+				"expected_headers":     map[string]interface{}{"Location": "https://aws.amazon.com/cloudfront/"},
+			},
+			Result: &cloudfront.TestResult{FunctionOutput: aws.String(redirect)},
+		},
+		{
+			Name: "response does not match",
+			TestMap: map[string]interface{}{
+				"expected_status_code": 200,
+				"expected_headers":     map[string]interface{}{"location": "https://example.com/", "x-missing": "true"},
+				"expected_uri":         "/index.html",
+			},
+			Result:   &cloudfront.TestResult{FunctionOutput: aws.String(redirect)},
+			ErrCount: 4,
+		},
+		{
+			Name: "request matches",
+			TestMap: map[string]interface{}{
+				"expected_headers": map[string]interface{}{"host": "www.example.com"},
+				"expected_uri":     "/index.html",
+			},
+			Result: &cloudfront.TestResult{FunctionOutput: aws.String(rewrite)},
+		},
+		{
+			Name: "request does not match",
+			TestMap: map[string]interface{}{
+				"expected_status_code": 302,
+				"expected_uri":         "/",
+			},
+			Result:   &cloudfront.TestResult{FunctionOutput: aws.String(rewrite)},
+			ErrCount: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := checkFunctionTestEvent(tc.TestMap, tc.Result)
+
+			var errCount int
+
+			if err != nil {
+				errCount = 1
+
+				if v, ok := err.(interface{ WrappedErrors() []error }); ok {
+					errCount = len(v.WrappedErrors())
+				}
+			}
+
+			if errCount != tc.ErrCount {
+				t.Errorf("expected %d errors, got %d: %v", tc.ErrCount, errCount, err)
+			}
+		})
+	}
+}
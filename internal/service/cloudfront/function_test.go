@@ -2,6 +2,7 @@ package cloudfront_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cloudfront"
@@ -279,6 +280,43 @@ func TestAccCloudFrontFunction_Update_comment(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontFunction_testEvent(t *testing.T) {
+	var conf cloudfront.DescribeFunctionOutput
+	resourceName := "aws_cloudfront_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCloudfrontFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestEventConfig(rName, 302),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "etag", resourceName, "live_stage_etag"),
+					resource.TestCheckResourceAttr(resourceName, "test_event.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "test_event_result.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "test_event_result.0.compute_utilization"),
+					resource.TestCheckResourceAttr(resourceName, "test_event_result.0.function_error_message", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "test_event_result.0.function_output"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "test_event", "test_event_result"},
+			},
+			{
+				Config:      testAccTestEventConfig(rName, 200),
+				ExpectError: regexp.MustCompile(`expected status code 200, got 302`),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudfrontFunctionDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
 
@@ -565,3 +603,50 @@ EOT
 }
 `, rName, comment)
 }
+
+func testAccTestEventConfig(rName string, statusCode int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-1.0"
+  code    = <<-EOT
+function handler(event) {
+	var response = {
+		statusCode: 302,
+		statusDescription: 'Found',
+		headers: {
+			'cloudfront-functions': { value: 'generated-by-CloudFront-Functions' },
+			'location': { value: 'https://aws.amazon.com/cloudfront/' }
+		}
+	};
+	console.log('redirecting ' + event.request.uri);
+	return response;
+}
+EOT
+
+  test_event {
+    event_object = jsonencode({
+      version = "1.0"
+      context = {
+        eventType = "viewer-request"
+      }
+      viewer = {
+        ip = "198.51.100.11"
+      }
+      request = {
+        method      = "GET"
+        uri         = "/index.html"
+        headers     = {}
+        cookies     = {}
+        querystring = {}
+      }
+    })
+
+    expected_status_code = %[2]d
+    expected_headers = {
+      location = "https://aws.amazon.com/cloudfront/"
+    }
+  }
+}
+`, rName, statusCode)
+}
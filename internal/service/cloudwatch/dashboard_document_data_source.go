@@ -0,0 +1,570 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceDashboardDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "inactive"}, false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"background": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"solid", "transparent"}, false),
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardWidgetHeightDefault,
+							ValidateFunc: validation.IntBetween(1, dashboardWidgetHeightMax),
+						},
+						"log_group_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"markdown": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"color": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), "must be a hex color such as #1f77b4"),
+									},
+									"dimensions": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"expression": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringMatch(dashboardMetricIDRegexp, "must start with a lowercase letter and contain only letters, numbers and underscores"),
+									},
+									"label": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"metric_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"visible": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"y_axis": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(dashboardYAxis_Values(), false),
+									},
+								},
+							},
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"position": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"x": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, dashboardGridWidth-1),
+									},
+									"y": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+						"sort_by": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"default", "stateUpdatedTimestamp", "timestamp"}, false),
+						},
+						"stacked": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"stat": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"states": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"ALARM", "INSUFFICIENT_DATA", "OK"}, false),
+							},
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(dashboardWidgetType_Values(), false),
+						},
+						"view": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(dashboardWidgetView_Values(), false),
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardWidgetWidthDefault,
+							ValidateFunc: validation.IntBetween(1, dashboardGridWidth),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	body := &DashboardBody{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+	}
+
+	var positioned []bool
+
+	for i, v := range d.Get("widget").([]interface{}) {
+		tfMap := v.(map[string]interface{})
+
+		widget, err := expandDashboardWidget(tfMap, meta.(*conns.AWSClient).Region)
+
+		if err != nil {
+			return fmt.Errorf("widget %d: %w", i, err)
+		}
+
+		if v, ok := tfMap["position"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			widget.X = tfMap["x"].(int)
+			widget.Y = tfMap["y"].(int)
+			positioned = append(positioned, true)
+		} else {
+			positioned = append(positioned, false)
+		}
+
+		body.Widgets = append(body.Widgets, widget)
+	}
+
+	if err := LayoutDashboardWidgets(body.Widgets, positioned); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(body)
+
+	if err != nil {
+		return fmt.Errorf("error marshalling CloudWatch Dashboard body: %w", err)
+	}
+
+	jsonString, err := structure.NormalizeJsonString(string(b))
+
+	if err != nil {
+		return fmt.Errorf("error normalizing CloudWatch Dashboard body: %w", err)
+	}
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(schema.HashString(jsonString)))
+
+	return nil
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, defaultRegion string) (*DashboardWidget, error) {
+	widget := &DashboardWidget{
+		Type:   tfMap["type"].(string),
+		Width:  tfMap["width"].(int),
+		Height: tfMap["height"].(int),
+	}
+
+	region := tfMap["region"].(string)
+
+	if region == "" {
+		region = defaultRegion
+	}
+
+	switch widget.Type {
+	case dashboardWidgetTypeMetric:
+		properties := &DashboardMetricWidgetProperties{
+			Period:  tfMap["period"].(int),
+			Region:  region,
+			Stacked: tfMap["stacked"].(bool),
+			Stat:    tfMap["stat"].(string),
+			Title:   tfMap["title"].(string),
+			View:    tfMap["view"].(string),
+		}
+
+		for _, v := range tfMap["metric"].([]interface{}) {
+			if v == nil {
+				continue
+			}
+
+			properties.Metrics = append(properties.Metrics, expandDashboardMetric(v.(map[string]interface{})))
+		}
+
+		if len(properties.Metrics) == 0 {
+			return nil, fmt.Errorf("a %s widget requires at least one metric", widget.Type)
+		}
+
+		if err := ValidateDashboardMetrics(properties.Metrics); err != nil {
+			return nil, err
+		}
+
+		switch properties.View {
+		case "", dashboardWidgetViewBar, dashboardWidgetViewGauge, dashboardWidgetViewPie, dashboardWidgetViewSingleValue, dashboardWidgetViewTimeSeries:
+		default:
+			return nil, fmt.Errorf("view %q is not supported by %s widgets", properties.View, widget.Type)
+		}
+
+		widget.Properties = properties
+	case dashboardWidgetTypeLog:
+		query := tfMap["query"].(string)
+
+		if query == "" {
+			return nil, fmt.Errorf("a %s widget requires a query", widget.Type)
+		}
+
+		var sources []string
+
+		for _, v := range aws.StringValueSlice(flex.ExpandStringList(tfMap["log_group_names"].([]interface{}))) {
+			sources = append(sources, fmt.Sprintf("SOURCE '%s'", v))
+		}
+
+		properties := &DashboardLogWidgetProperties{
+			Query:   strings.Join(append(sources, query), " | "),
+			Region:  region,
+			Stacked: tfMap["stacked"].(bool),
+			Title:   tfMap["title"].(string),
+			View:    tfMap["view"].(string),
+		}
+
+		switch properties.View {
+		case "", dashboardWidgetViewBar, dashboardWidgetViewPie, dashboardWidgetViewTable, dashboardWidgetViewTimeSeries:
+		default:
+			return nil, fmt.Errorf("view %q is not supported by %s widgets", properties.View, widget.Type)
+		}
+
+		widget.Properties = properties
+	case dashboardWidgetTypeAlarm:
+		properties := &DashboardAlarmWidgetProperties{
+			Alarms: aws.StringValueSlice(flex.ExpandStringList(tfMap["alarms"].([]interface{}))),
+			SortBy: tfMap["sort_by"].(string),
+			States: aws.StringValueSlice(flex.ExpandStringList(tfMap["states"].([]interface{}))),
+			Title:  tfMap["title"].(string),
+		}
+
+		if len(properties.Alarms) == 0 {
+			return nil, fmt.Errorf("an %s widget requires at least one alarm", widget.Type)
+		}
+
+		widget.Properties = properties
+	case dashboardWidgetTypeText:
+		properties := &DashboardTextWidgetProperties{
+			Background: tfMap["background"].(string),
+			Markdown:   tfMap["markdown"].(string),
+		}
+
+		if properties.Markdown == "" {
+			return nil, fmt.Errorf("a %s widget requires markdown", widget.Type)
+		}
+
+		widget.Properties = properties
+	}
+
+	return widget, nil
+}
+
+func expandDashboardMetric(tfMap map[string]interface{}) DashboardMetric {
+	metric := DashboardMetric{
+		MetricName: tfMap["metric_name"].(string),
+		Namespace:  tfMap["namespace"].(string),
+		Options: DashboardMetricOptions{
+			Color:      tfMap["color"].(string),
+			Expression: tfMap["expression"].(string),
+			ID:         tfMap["id"].(string),
+			Label:      tfMap["label"].(string),
+			Period:     tfMap["period"].(int),
+			Stat:       tfMap["stat"].(string),
+			YAxis:      tfMap["y_axis"].(string),
+		},
+	}
+
+	if !tfMap["visible"].(bool) {
+		visible := false
+		metric.Options.Visible = &visible
+	}
+
+	dimensions := tfMap["dimensions"].(map[string]interface{})
+	names := make([]string, 0, len(dimensions))
+
+	for name := range dimensions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		metric.Dimensions = append(metric.Dimensions, [2]string{name, dimensions[name].(string)})
+	}
+
+	return metric
+}
+
+var (
+	dashboardMetricIDRegexp = regexp.MustCompile(`^[a-z][a-zA-Z0-9_]*$`)
+
+	// Metric math functions are upper case and metric IDs start with a lower case letter,
+	// so any identifier starting with a lower case letter in an expression is a metric ID.
+	dashboardMetricExpressionIDRegexp     = regexp.MustCompile(`\b[a-z][a-zA-Z0-9_]*\b`)
+	dashboardMetricExpressionStringRegexp = regexp.MustCompile(`'[^']*'|"[^"]*"`)
+)
+
+// ValidateDashboardMetrics checks the metrics of a single metric widget: each is either a
+// metric or a metric math expression, IDs are unique, and expressions are well formed and
+// only reference the IDs of other metrics in the widget.
+func ValidateDashboardMetrics(metrics []DashboardMetric) error {
+	ids := make(map[string]bool)
+
+	for i, metric := range metrics {
+		if id := metric.Options.ID; id != "" {
+			if !dashboardMetricIDRegexp.MatchString(id) {
+				return fmt.Errorf("metric %d: invalid ID %q", i, id)
+			}
+
+			if ids[id] {
+				return fmt.Errorf("metric %d: duplicate ID %q", i, id)
+			}
+
+			ids[id] = true
+		}
+	}
+
+	for i, metric := range metrics {
+		expression := metric.Options.Expression
+
+		if expression == "" {
+			if metric.Namespace == "" || metric.MetricName == "" {
+				return fmt.Errorf("metric %d: either expression or both namespace and metric_name must be set", i)
+			}
+
+			continue
+		}
+
+		if metric.Namespace != "" || metric.MetricName != "" || len(metric.Dimensions) > 0 {
+			return fmt.Errorf("metric %d: expression cannot be combined with namespace, metric_name or dimensions", i)
+		}
+
+		if err := validateDashboardMetricExpression(expression, metric.Options.ID, ids); err != nil {
+			return fmt.Errorf("metric %d: invalid expression %q: %w", i, expression, err)
+		}
+	}
+
+	return nil
+}
+
+func validateDashboardMetricExpression(expression, id string, ids map[string]bool) error {
+	// String literals, such as SEARCH expressions, are not parsed.
+	expression = dashboardMetricExpressionStringRegexp.ReplaceAllString(expression, "0")
+
+	if strings.ContainsAny(expression, `'"`) {
+		return fmt.Errorf("unterminated string")
+	}
+
+	var stack []rune
+	pairs := map[rune]rune{')': '(', ']': '['}
+
+	for _, r := range expression {
+		switch r {
+		case '(', '[':
+			stack = append(stack, r)
+		case ')', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[r] {
+				return fmt.Errorf("unbalanced %q", r)
+			}
+
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if len(stack) > 0 {
+		return fmt.Errorf("unbalanced %q", stack[len(stack)-1])
+	}
+
+	for _, ref := range dashboardMetricExpressionIDRegexp.FindAllString(expression, -1) {
+		if ref == id {
+			return fmt.Errorf("expression references itself")
+		}
+
+		if !ids[ref] {
+			return fmt.Errorf("unknown metric ID %q", ref)
+		}
+	}
+
+	return nil
+}
+
+// LayoutDashboardWidgets places widgets on the dashboard's 24 column grid.
+// Widgets with an explicit position are left in place and must fit within the grid. The remaining
+// widgets flow left to right, top to bottom, in order, skipping over any cells already occupied.
+func LayoutDashboardWidgets(widgets []*DashboardWidget, positioned []bool) error {
+	var placed []*DashboardWidget
+
+	for i, widget := range widgets {
+		if !positioned[i] {
+			continue
+		}
+
+		if widget.X+widget.Width > dashboardGridWidth {
+			return fmt.Errorf("widget %d: x (%d) plus width (%d) exceeds the dashboard width (%d)", i, widget.X, widget.Width, dashboardGridWidth)
+		}
+
+		for j, other := range widgets[:i] {
+			if positioned[j] && dashboardWidgetsOverlap(widget, other) {
+				return fmt.Errorf("widget %d overlaps widget %d", i, j)
+			}
+		}
+
+		placed = append(placed, widget)
+	}
+
+	x, y := 0, 0
+
+	for i, widget := range widgets {
+		if positioned[i] {
+			continue
+		}
+
+		for {
+			if x+widget.Width > dashboardGridWidth {
+				x = 0
+				y++
+
+				continue
+			}
+
+			widget.X, widget.Y = x, y
+
+			var blocker *DashboardWidget
+
+			for _, other := range placed {
+				if dashboardWidgetsOverlap(widget, other) {
+					blocker = other
+					break
+				}
+			}
+
+			if blocker == nil {
+				break
+			}
+
+			x = blocker.X + blocker.Width
+		}
+
+		placed = append(placed, widget)
+		x += widget.Width
+	}
+
+	return nil
+}
+
+func dashboardWidgetsOverlap(a, b *DashboardWidget) bool {
+	return a.X < b.X+b.Width && b.X < a.X+a.Width && a.Y < b.Y+b.Height && b.Y < a.Y+a.Height
+}
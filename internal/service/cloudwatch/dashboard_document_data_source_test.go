@@ -0,0 +1,303 @@
+package cloudwatch_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+)
+
+func TestLayoutDashboardWidgets(t *testing.T) {
+	widgets := []*tfcloudwatch.DashboardWidget{
+		{Width: 12, Height: 6},
+		{Width: 6, Height: 3, X: 12, Y: 0},
+		{Width: 12, Height: 6},
+		{Width: 24, Height: 2},
+	}
+	positioned := []bool{false, true, false, false}
+
+	if err := tfcloudwatch.LayoutDashboardWidgets(widgets, positioned); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := [][2]int{{0, 0}, {12, 0}, {12, 3}, {0, 9}}
+
+	for i, widget := range widgets {
+		if got := [2]int{widget.X, widget.Y}; got != expected[i] {
+			t.Errorf("widget %d: expected position %v, got %v", i, expected[i], got)
+		}
+	}
+}
+
+func TestLayoutDashboardWidgets_errors(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Widgets    []*tfcloudwatch.DashboardWidget
+		Positioned []bool
+		Error      string
+	}{
+		{
+			Name:       "too wide",
+			Widgets:    []*tfcloudwatch.DashboardWidget{{X: 20, Width: 6, Height: 6}},
+			Positioned: []bool{true},
+			Error:      "exceeds the dashboard width",
+		},
+		{
+			Name:       "overlap",
+			Widgets:    []*tfcloudwatch.DashboardWidget{{Width: 6, Height: 6}, {X: 3, Y: 3, Width: 6, Height: 6}},
+			Positioned: []bool{true, true},
+			Error:      "widget 1 overlaps widget 0",
+		},
+		{
+			Name:       "overlap after auto-placed widget",
+			Widgets:    []*tfcloudwatch.DashboardWidget{{Width: 6, Height: 6}, {X: 12, Width: 6, Height: 6}, {Width: 6, Height: 6}, {X: 15, Y: 3, Width: 6, Height: 6}},
+			Positioned: []bool{false, true, false, true},
+			Error:      "widget 3 overlaps widget 1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := tfcloudwatch.LayoutDashboardWidgets(testCase.Widgets, testCase.Positioned)
+
+			if err == nil || !regexp.MustCompile(testCase.Error).MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got %v", testCase.Error, err)
+			}
+		})
+	}
+}
+
+func TestValidateDashboardMetrics(t *testing.T) {
+	metric := func(id string) tfcloudwatch.DashboardMetric {
+		return tfcloudwatch.DashboardMetric{
+			Namespace:  "AWS/EC2",
+			MetricName: "CPUUtilization",
+			Options:    tfcloudwatch.DashboardMetricOptions{ID: id},
+		}
+	}
+	expression := func(id, expression string) tfcloudwatch.DashboardMetric {
+		return tfcloudwatch.DashboardMetric{
+			Options: tfcloudwatch.DashboardMetricOptions{ID: id, Expression: expression},
+		}
+	}
+
+	testCases := []struct {
+		Name    string
+		Metrics []tfcloudwatch.DashboardMetric
+		Error   string
+	}{
+		{
+			Name:    "valid",
+			Metrics: []tfcloudwatch.DashboardMetric{metric("m1"), metric("m2"), expression("e1", "100 * (m1 + m2) / SUM(METRICS())")},
+		},
+		{
+			Name:    "search",
+			Metrics: []tfcloudwatch.DashboardMetric{expression("e1", `SEARCH('{AWS/EC2,InstanceId} MetricName="CPUUtilization"', 'Average', 300)`)},
+		},
+		{
+			Name:    "missing metric name",
+			Metrics: []tfcloudwatch.DashboardMetric{{Namespace: "AWS/EC2"}},
+			Error:   "either expression or both namespace and metric_name must be set",
+		},
+		{
+			Name:    "duplicate ID",
+			Metrics: []tfcloudwatch.DashboardMetric{metric("m1"), metric("m1")},
+			Error:   `duplicate ID "m1"`,
+		},
+		{
+			Name:    "unknown ID",
+			Metrics: []tfcloudwatch.DashboardMetric{metric("m1"), expression("e1", "m1 + m2")},
+			Error:   `unknown metric ID "m2"`,
+		},
+		{
+			Name:    "self reference",
+			Metrics: []tfcloudwatch.DashboardMetric{expression("e1", "RATE(e1)")},
+			Error:   "references itself",
+		},
+		{
+			Name:    "unbalanced",
+			Metrics: []tfcloudwatch.DashboardMetric{metric("m1"), expression("e1", "RATE(m1")},
+			Error:   "unbalanced",
+		},
+		{
+			Name:    "unterminated string",
+			Metrics: []tfcloudwatch.DashboardMetric{expression("e1", "SEARCH('{AWS/EC2}, 'Average', 300)")},
+			Error:   "unterminated string",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := tfcloudwatch.ValidateDashboardMetrics(testCase.Metrics)
+
+			if testCase.Error == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !regexp.MustCompile(regexp.QuoteMeta(testCase.Error)).MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got %v", testCase.Error, err)
+			}
+		})
+	}
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+	resourceName := "aws_cloudwatch_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "dashboard_body", dataSourceName, "json"),
+					testAccCloudWatchCheckDashboardBodyIsExpected(resourceName, testAccDashboardDocumentExpectedJSON(acctest.Region())),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalidExpression(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_invalidExpression,
+				ExpectError: regexp.MustCompile(`widget 0: metric 1: invalid expression "m1 \* m2": unknown metric ID "m2"`),
+			},
+		},
+	})
+}
+
+func testAccDashboardDocumentExpectedJSON(region string) string {
+	return `{
+  "widgets": [
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "width": 24,
+      "height": 2,
+      "properties": {
+        "markdown": "# Instances"
+      }
+    },
+    {
+      "type": "metric",
+      "x": 0,
+      "y": 2,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/EC2", "CPUUtilization", "AutoScalingGroupName", "example", {"id": "m1", "visible": false}],
+          [{"expression": "m1 * 100", "id": "e1", "label": "CPU %"}]
+        ],
+        "region": "` + region + `",
+        "stat": "Average",
+        "title": "CPU"
+      }
+    },
+    {
+      "type": "log",
+      "x": 12,
+      "y": 2,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "query": "SOURCE '/aws/lambda/example' | fields @timestamp, @message | limit 20",
+        "region": "` + region + `",
+        "title": "Logs",
+        "view": "table"
+      }
+    }
+  ]
+}`
+}
+
+func testAccDashboardDocumentDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    type     = "text"
+    width    = 24
+    height   = 2
+    markdown = "# Instances"
+  }
+
+  widget {
+    type  = "metric"
+    width = 12
+    title = "CPU"
+    stat  = "Average"
+
+    metric {
+      id          = "m1"
+      namespace   = "AWS/EC2"
+      metric_name = "CPUUtilization"
+      visible     = false
+
+      dimensions = {
+        AutoScalingGroupName = "example"
+      }
+    }
+
+    metric {
+      id         = "e1"
+      expression = "m1 * 100"
+      label      = "CPU %%"
+    }
+  }
+
+  widget {
+    type            = "log"
+    width           = 12
+    title           = "Logs"
+    view            = "table"
+    log_group_names = ["/aws/lambda/example"]
+    query           = "fields @timestamp, @message | limit 20"
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
+
+const testAccDashboardDocumentDataSourceConfig_invalidExpression = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    type = "metric"
+
+    metric {
+      id          = "m1"
+      namespace   = "AWS/EC2"
+      metric_name = "CPUUtilization"
+    }
+
+    metric {
+      id         = "e2"
+      expression = "m1 * m2"
+    }
+  }
+}
+`
@@ -0,0 +1,149 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+)
+
+// Dashboard body structure.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+
+const (
+	dashboardGridWidth = 24
+
+	dashboardWidgetHeightDefault = 6
+	dashboardWidgetHeightMax     = 1000
+	dashboardWidgetWidthDefault  = 6
+)
+
+const (
+	dashboardWidgetTypeAlarm  = "alarm"
+	dashboardWidgetTypeLog    = "log"
+	dashboardWidgetTypeMetric = "metric"
+	dashboardWidgetTypeText   = "text"
+)
+
+func dashboardWidgetType_Values() []string {
+	return []string{
+		dashboardWidgetTypeAlarm,
+		dashboardWidgetTypeLog,
+		dashboardWidgetTypeMetric,
+		dashboardWidgetTypeText,
+	}
+}
+
+const (
+	dashboardWidgetViewBar         = "bar"
+	dashboardWidgetViewGauge       = "gauge"
+	dashboardWidgetViewPie         = "pie"
+	dashboardWidgetViewSingleValue = "singleValue"
+	dashboardWidgetViewTable       = "table"
+	dashboardWidgetViewTimeSeries  = "timeSeries"
+)
+
+func dashboardWidgetView_Values() []string {
+	return []string{
+		dashboardWidgetViewBar,
+		dashboardWidgetViewGauge,
+		dashboardWidgetViewPie,
+		dashboardWidgetViewSingleValue,
+		dashboardWidgetViewTable,
+		dashboardWidgetViewTimeSeries,
+	}
+}
+
+const (
+	dashboardYAxisLeft  = "left"
+	dashboardYAxisRight = "right"
+)
+
+func dashboardYAxis_Values() []string {
+	return []string{
+		dashboardYAxisLeft,
+		dashboardYAxisRight,
+	}
+}
+
+type DashboardBody struct {
+	Start          string             `json:"start,omitempty"`
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Widgets        []*DashboardWidget `json:"widgets"`
+}
+
+type DashboardWidget struct {
+	Type       string      `json:"type"`
+	X          int         `json:"x"`
+	Y          int         `json:"y"`
+	Width      int         `json:"width"`
+	Height     int         `json:"height"`
+	Properties interface{} `json:"properties"`
+}
+
+type DashboardMetricWidgetProperties struct {
+	Metrics []DashboardMetric `json:"metrics"`
+	Period  int               `json:"period,omitempty"`
+	Region  string            `json:"region"`
+	Stacked bool              `json:"stacked,omitempty"`
+	Stat    string            `json:"stat,omitempty"`
+	Title   string            `json:"title,omitempty"`
+	View    string            `json:"view,omitempty"`
+}
+
+// DashboardMetric is one entry of a metric widget's "metrics" array, either a metric
+// ([namespace, metric name, dimension name, dimension value, ..., {options}]) or a
+// metric math expression ([{options}]).
+type DashboardMetric struct {
+	Namespace  string
+	MetricName string
+	Dimensions [][2]string
+	Options    DashboardMetricOptions
+}
+
+type DashboardMetricOptions struct {
+	Color      string `json:"color,omitempty"`
+	Expression string `json:"expression,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Label      string `json:"label,omitempty"`
+	Period     int    `json:"period,omitempty"`
+	Stat       string `json:"stat,omitempty"`
+	Visible    *bool  `json:"visible,omitempty"`
+	YAxis      string `json:"yAxis,omitempty"`
+}
+
+func (m DashboardMetric) MarshalJSON() ([]byte, error) {
+	var elems []interface{}
+
+	if m.Options.Expression == "" {
+		elems = append(elems, m.Namespace, m.MetricName)
+
+		for _, dimension := range m.Dimensions {
+			elems = append(elems, dimension[0], dimension[1])
+		}
+	}
+
+	if m.Options != (DashboardMetricOptions{}) {
+		elems = append(elems, m.Options)
+	}
+
+	return json.Marshal(elems)
+}
+
+type DashboardLogWidgetProperties struct {
+	Query   string `json:"query"`
+	Region  string `json:"region"`
+	Stacked bool   `json:"stacked,omitempty"`
+	Title   string `json:"title,omitempty"`
+	View    string `json:"view,omitempty"`
+}
+
+type DashboardAlarmWidgetProperties struct {
+	Alarms []string `json:"alarms"`
+	SortBy string   `json:"sortBy,omitempty"`
+	States []string `json:"states,omitempty"`
+	Title  string   `json:"title,omitempty"`
+}
+
+type DashboardTextWidgetProperties struct {
+	Background string `json:"background,omitempty"`
+	Markdown   string `json:"markdown"`
+}
@@ -0,0 +1,159 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dashboardWidgetPropertyDefaults are the values CloudWatch assumes for omitted widget properties,
+// keyed by widget type.
+var dashboardWidgetPropertyDefaults = map[string]map[string]interface{}{
+	dashboardWidgetTypeLog: {
+		"stacked": false,
+		"view":    dashboardWidgetViewTable,
+	},
+	dashboardWidgetTypeMetric: {
+		"period":  float64(300),
+		"stacked": false,
+		"view":    dashboardWidgetViewTimeSeries,
+	},
+	dashboardWidgetTypeText: {
+		"background": "solid",
+	},
+}
+
+// dashboardMetricOptionDefaults are the values CloudWatch assumes for omitted metric rendering options.
+var dashboardMetricOptionDefaults = map[string]interface{}{
+	"visible": true,
+	"yAxis":   dashboardYAxisLeft,
+}
+
+func suppressEquivalentDashboardBodyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	equivalent, err := DashboardBodiesAreEquivalent(old, new)
+
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+// DashboardBodiesAreEquivalent determines whether two CloudWatch dashboard body JSON strings describe
+// the same dashboard, ignoring key order, properties holding their default value and, when every
+// widget has an explicit position, the order of the widgets.
+func DashboardBodiesAreEquivalent(body1, body2 string) (bool, error) {
+	obj1, err := normalizeDashboardBody(body1)
+
+	if err != nil {
+		return false, err
+	}
+
+	obj2, err := normalizeDashboardBody(body2)
+
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(obj1, obj2), nil
+}
+
+func normalizeDashboardBody(body string) (map[string]interface{}, error) {
+	var obj map[string]interface{}
+
+	if err := json.Unmarshal([]byte(body), &obj); err != nil {
+		return nil, err
+	}
+
+	widgets, _ := obj["widgets"].([]interface{})
+	positioned := true
+
+	for _, v := range widgets {
+		widget, ok := v.(map[string]interface{})
+
+		if !ok {
+			positioned = false
+			continue
+		}
+
+		if widget["width"] == float64(dashboardWidgetWidthDefault) {
+			delete(widget, "width")
+		}
+
+		if widget["height"] == float64(dashboardWidgetHeightDefault) {
+			delete(widget, "height")
+		}
+
+		if _, ok := widget["x"]; !ok {
+			positioned = false
+		}
+
+		if _, ok := widget["y"]; !ok {
+			positioned = false
+		}
+
+		properties, ok := widget["properties"].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		widgetType, _ := widget["type"].(string)
+
+		for k, v := range dashboardWidgetPropertyDefaults[widgetType] {
+			if reflect.DeepEqual(properties[k], v) {
+				delete(properties, k)
+			}
+		}
+
+		metrics, _ := properties["metrics"].([]interface{})
+
+		for i, v := range metrics {
+			metric, ok := v.([]interface{})
+
+			if !ok || len(metric) == 0 {
+				continue
+			}
+
+			options, ok := metric[len(metric)-1].(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			for k, v := range dashboardMetricOptionDefaults {
+				if reflect.DeepEqual(options[k], v) {
+					delete(options, k)
+				}
+			}
+
+			if len(options) == 0 {
+				metrics[i] = metric[:len(metric)-1]
+			}
+		}
+	}
+
+	// Widgets without a position are laid out in order, so their order is only insignificant
+	// when all widgets are positioned.
+	if positioned {
+		sort.SliceStable(widgets, func(i, j int) bool {
+			wi, wj := widgets[i].(map[string]interface{}), widgets[j].(map[string]interface{})
+
+			if !reflect.DeepEqual(wi["y"], wj["y"]) {
+				yi, _ := wi["y"].(float64)
+				yj, _ := wj["y"].(float64)
+
+				return yi < yj
+			}
+
+			xi, _ := wi["x"].(float64)
+			xj, _ := wj["x"].(float64)
+
+			return xi < xj
+		})
+	}
+
+	return obj, nil
+}
@@ -17,6 +17,60 @@ import (
 	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
 )
 
+func TestDashboardBodiesAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Body1      string
+		Body2      string
+		Equivalent bool
+	}{
+		{
+			Name:       "key order",
+			Body1:      `{"widgets":[{"type":"text","x":0,"y":0,"width":6,"height":6,"properties":{"markdown":"hi"}}]}`,
+			Body2:      `{"widgets":[{"properties":{"markdown":"hi"},"height":6,"width":6,"y":0,"x":0,"type":"text"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "default values",
+			Body1:      `{"widgets":[{"type":"metric","x":0,"y":0,"properties":{"region":"us-west-2","metrics":[["AWS/EC2","CPUUtilization"]]}}]}`,
+			Body2:      `{"widgets":[{"type":"metric","x":0,"y":0,"width":6,"height":6,"properties":{"region":"us-west-2","view":"timeSeries","stacked":false,"period":300,"metrics":[["AWS/EC2","CPUUtilization",{"visible":true,"yAxis":"left"}]]}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "positioned widget order",
+			Body1:      `{"widgets":[{"type":"text","x":0,"y":0,"properties":{"markdown":"a"}},{"type":"text","x":6,"y":0,"properties":{"markdown":"b"}}]}`,
+			Body2:      `{"widgets":[{"type":"text","x":6,"y":0,"properties":{"markdown":"b"}},{"type":"text","x":0,"y":0,"properties":{"markdown":"a"}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "unpositioned widget order",
+			Body1:      `{"widgets":[{"type":"text","properties":{"markdown":"a"}},{"type":"text","properties":{"markdown":"b"}}]}`,
+			Body2:      `{"widgets":[{"type":"text","properties":{"markdown":"b"}},{"type":"text","properties":{"markdown":"a"}}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "different values",
+			Body1:      `{"widgets":[{"type":"metric","x":0,"y":0,"properties":{"region":"us-west-2","period":60,"metrics":[["AWS/EC2","CPUUtilization"]]}}]}`,
+			Body2:      `{"widgets":[{"type":"metric","x":0,"y":0,"properties":{"region":"us-west-2","metrics":[["AWS/EC2","CPUUtilization"]]}}]}`,
+			Equivalent: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			equivalent, err := tfcloudwatch.DashboardBodiesAreEquivalent(testCase.Body1, testCase.Body2)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if equivalent != testCase.Equivalent {
+				t.Errorf("expected equivalent %t, got %t", testCase.Equivalent, equivalent)
+			}
+		})
+	}
+}
+
 func TestAccCloudWatchDashboard_basic(t *testing.T) {
 	var dashboard cloudwatch.GetDashboardOutput
 	resourceName := "aws_cloudwatch_dashboard.test"
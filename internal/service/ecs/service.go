@@ -37,6 +37,8 @@ func ResourceService() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(serviceStableTimeout),
+			Update: schema.DefaultTimeout(serviceStableTimeout),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
@@ -343,6 +345,10 @@ func ResourceService() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"wait_for_task_definition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -552,8 +558,8 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	cluster := d.Get("cluster").(string)
 
-	if d.Get("wait_for_steady_state").(bool) {
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+	if v := d.Get("wait_for_task_definition").(string); v != "" || d.Get("wait_for_steady_state").(bool) {
+		if _, err := waitServiceStable(conn, d.Id(), cluster, v, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to reach steady state after creation: %w", d.Id(), err)
 		}
 	} else {
@@ -1113,8 +1119,8 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		cluster := d.Get("cluster").(string)
-		if d.Get("wait_for_steady_state").(bool) {
-			if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if v := d.Get("wait_for_task_definition").(string); v != "" || d.Get("wait_for_steady_state").(bool) {
+			if _, err := waitServiceStable(conn, d.Id(), cluster, v, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return fmt.Errorf("error waiting for ECS service (%s) to reach steady state after update: %w", d.Id(), err)
 			}
 		} else {
@@ -20,6 +20,8 @@ const (
 	serviceStatusError = "ERROR"
 	serviceStatusNone  = "NONE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
 
@@ -86,6 +88,18 @@ func statusService(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc
 	}
 }
 
+func statusServiceDeployment(monitor *serviceDeploymentMonitor) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, status, err := monitor.refresh()
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, status, nil
+	}
+}
+
 func statusCluster(ctx context.Context, conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		cluster, err := FindClusterByNameOrARN(ctx, conn, arn)
@@ -2,6 +2,7 @@ package ecs
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -49,7 +51,10 @@ func ResourceTaskDefinition() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceTaskDefinitionContainerDefinitionsCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  resourceTaskDefinitionMigrateState,
@@ -81,6 +86,11 @@ func ResourceTaskDefinition() *schema.Resource {
 				},
 				ValidateFunc: ValidTaskDefinitionContainerDefinitions,
 			},
+			"container_definitions_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"cpu": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -597,6 +607,9 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("family", taskDefinition.Family)
 	d.Set("revision", taskDefinition.Revision)
 
+	// The changes are only reported in the plan.
+	d.Set("container_definitions_changes", []string{})
+
 	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
 	// some other property changes).
@@ -1192,6 +1205,32 @@ func flattenFsxWinVolumeAuthorizationConfig(config *ecs.FSxWindowsFileServerAuth
 	return items
 }
 
+// resourceTaskDefinitionContainerDefinitionsCustomizeDiff records the container definition
+// fields whose change forces a new task definition revision, so that they are shown in the plan.
+func resourceTaskDefinitionContainerDefinitionsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("container_definitions") {
+		return nil
+	}
+
+	o, n := diff.GetChange("container_definitions")
+	isAWSVPC := diff.Get("network_mode").(string) == ecs.NetworkModeAwsvpc
+
+	paths, err := ContainerDefinitionsDiff(o.(string), n.(string), isAWSVPC)
+
+	// The new value may not be known until apply.
+	if err != nil {
+		return diff.SetNewComputed("container_definitions_changes")
+	}
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	log.Printf("[WARN] ECS Task Definition (%s) container_definitions changes force a new revision: %s", diff.Id(), strings.Join(paths, ", "))
+
+	return diff.SetNew("container_definitions_changes", paths)
+}
+
 func flattenContainerDefinitions(definitions []*ecs.ContainerDefinition) (string, error) {
 	b, err := jsonutil.BuildJSON(definitions)
 	if err != nil {
@@ -1,105 +1,393 @@
 package ecs
 
 import (
-	"bytes"
 	"encoding/json"
+	"fmt"
 	"log"
 	"reflect"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/ecs"
-	"github.com/mitchellh/copystructure"
 )
 
+// containerDefinitionDefaults are the values ECS assumes for container definition fields that
+// are omitted, keyed by the path of the field within a container definition. A path segment
+// ending in "[]" applies to every element of that list.
+// Fields holding their default value are removed before definitions are compared.
+var containerDefinitionDefaults = map[string]interface{}{
+	"cpu":                                float64(0),
+	"disableNetworking":                  false,
+	"essential":                          true,
+	"interactive":                        false,
+	"mountPoints[].readOnly":             false,
+	"portMappings[].hostPort":            float64(0),
+	"portMappings[].protocol":            ecs.TransportProtocolTcp,
+	"privileged":                         false,
+	"pseudoTerminal":                     false,
+	"readonlyRootFilesystem":             false,
+	"volumesFrom[].readOnly":             false,
+	"linuxParameters.initProcessEnabled": false,
+}
+
+// containerDefinitionUnorderedLists are the container definition lists whose order is not
+// significant, mapped to the field that identifies each element.
+var containerDefinitionUnorderedLists = map[string]string{
+	"environment":    "name",
+	"extraHosts":     "hostname",
+	"secrets":        "name",
+	"systemControls": "namespace",
+	"ulimits":        "name",
+}
+
+// containerDefinitionAWSVPCDefaultUlimits are the resource limits applied to containers by
+// Fargate, which requires the awsvpc network mode, when none are specified.
+var containerDefinitionAWSVPCDefaultUlimits = []map[string]interface{}{
+	{
+		"name":      ecs.UlimitNameNofile,
+		"softLimit": float64(1024),
+		"hardLimit": float64(65535),
+	},
+}
+
 // ContainerDefinitionsAreEquivalent determines equality between two ECS container definition JSON strings
 // Note: This function will be moved out of the aws package in the future.
 func ContainerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool, error) {
-	var obj1 containerDefinitions
-	err := json.Unmarshal([]byte(def1), &obj1)
+	obj1, err := normalizeContainerDefinitions(def1, isAWSVPC)
 	if err != nil {
 		return false, err
 	}
-	err = obj1.Reduce(isAWSVPC)
+
+	obj2, err := normalizeContainerDefinitions(def2, isAWSVPC)
 	if err != nil {
 		return false, err
 	}
-	canonicalJson1, err := jsonutil.BuildJSON(obj1)
-	if err != nil {
-		return false, err
+
+	equal := reflect.DeepEqual(obj1, obj2)
+	if !equal {
+		log.Printf("[DEBUG] Canonical definitions are not equal.\nFirst: %v\nSecond: %v\n",
+			obj1, obj2)
 	}
+	return equal, nil
+}
 
-	var obj2 containerDefinitions
-	err = json.Unmarshal([]byte(def2), &obj2)
+// ContainerDefinitionsDiff returns the paths of the fields that differ between two ECS container
+// definition JSON strings once both have been normalized, for example
+// "wordpress.portMappings[0].hostPort" or "mysql.environment[MYSQL_ROOT_PASSWORD].value".
+// Containers are matched by name.
+func ContainerDefinitionsDiff(def1, def2 string, isAWSVPC bool) ([]string, error) {
+	obj1, err := normalizeContainerDefinitions(def1, isAWSVPC)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	err = obj2.Reduce(isAWSVPC)
+
+	obj2, err := normalizeContainerDefinitions(def2, isAWSVPC)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	canonicalJson2, err := jsonutil.BuildJSON(obj2)
-	if err != nil {
-		return false, err
+	containers1 := containerDefinitionsByName(obj1)
+	containers2 := containerDefinitionsByName(obj2)
+
+	var paths []string
+
+	for name, container1 := range containers1 {
+		container2, ok := containers2[name]
+
+		if !ok {
+			paths = append(paths, name)
+			continue
+		}
+
+		paths = append(paths, containerDefinitionDiffPaths(name, "", container1, container2)...)
 	}
 
-	equal := bytes.Equal(canonicalJson1, canonicalJson2)
-	if !equal {
-		log.Printf("[DEBUG] Canonical definitions are not equal.\nFirst: %s\nSecond: %s\n",
-			canonicalJson1, canonicalJson2)
+	for name := range containers2 {
+		if _, ok := containers1[name]; !ok {
+			paths = append(paths, name)
+		}
 	}
-	return equal, nil
+
+	sort.Strings(paths)
+
+	return paths, nil
 }
 
 type containerDefinitions []*ecs.ContainerDefinition
 
-func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
-	// Deal with fields which may be re-ordered in the API
-	cd.OrderEnvironmentVariables()
+// normalizeContainerDefinitions decodes container definitions JSON into a generic form from
+// which default values, empty collections and insignificant ordering have been removed.
+func normalizeContainerDefinitions(def string, isAWSVPC bool) ([]interface{}, error) {
+	var cd containerDefinitions
+	err := json.Unmarshal([]byte(def), &cd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Round trip through the API shapes so that only known fields, in canonical form, remain.
+	canonicalJson, err := jsonutil.BuildJSON(cd)
+	if err != nil {
+		return nil, err
+	}
+
+	var definitions []interface{}
+	err = json.Unmarshal(canonicalJson, &definitions)
+	if err != nil {
+		return nil, err
+	}
 
-	for i, def := range cd {
-		// Deal with special fields which have defaults
-		if def.Cpu != nil && *def.Cpu == 0 {
-			def.Cpu = nil
+	for _, v := range definitions {
+		definition, ok := v.(map[string]interface{})
+		if !ok {
+			continue
 		}
-		if def.Essential == nil {
-			def.Essential = aws.Bool(true)
+
+		for path, value := range containerDefinitionDefaults {
+			removeContainerDefinitionDefault(definition, strings.Split(path, "."), value)
 		}
-		for j, pm := range def.PortMappings {
-			if pm.Protocol != nil && *pm.Protocol == "tcp" {
-				cd[i].PortMappings[j].Protocol = nil
+
+		if isAWSVPC {
+			// In awsvpc network mode the host port is always the container port.
+			if portMappings, ok := definition["portMappings"].([]interface{}); ok {
+				for _, v := range portMappings {
+					if pm, ok := v.(map[string]interface{}); ok {
+						if _, ok := pm["hostPort"]; !ok && pm["containerPort"] != nil {
+							pm["hostPort"] = pm["containerPort"]
+						}
+					}
+				}
 			}
-			if pm.HostPort != nil && *pm.HostPort == 0 {
-				cd[i].PortMappings[j].HostPort = nil
+
+			if ulimits, ok := definition["ulimits"].([]interface{}); ok {
+				definition["ulimits"] = removeContainerDefinitionDefaultElements(ulimits, containerDefinitionAWSVPCDefaultUlimits)
+			}
+		}
+
+		for field, key := range containerDefinitionUnorderedLists {
+			if list, ok := definition[field].([]interface{}); ok {
+				sortContainerDefinitionList(list, key)
+			}
+		}
+
+		pruneContainerDefinitionEmptyValues(definition)
+	}
+
+	return definitions, nil
+}
+
+func removeContainerDefinitionDefault(obj map[string]interface{}, path []string, value interface{}) {
+	segment := path[0]
+
+	if len(path) == 1 {
+		if v, ok := obj[segment]; ok && reflect.DeepEqual(v, value) {
+			delete(obj, segment)
+		}
+
+		return
+	}
+
+	if field := strings.TrimSuffix(segment, "[]"); field != segment {
+		list, _ := obj[field].([]interface{})
+
+		for _, v := range list {
+			if elem, ok := v.(map[string]interface{}); ok {
+				removeContainerDefinitionDefault(elem, path[1:], value)
 			}
-			if isAWSVPC && cd[i].PortMappings[j].HostPort == nil {
-				cd[i].PortMappings[j].HostPort = cd[i].PortMappings[j].ContainerPort
+		}
+
+		return
+	}
+
+	if child, ok := obj[segment].(map[string]interface{}); ok {
+		removeContainerDefinitionDefault(child, path[1:], value)
+	}
+}
+
+func removeContainerDefinitionDefaultElements(list []interface{}, defaults []map[string]interface{}) []interface{} {
+	var result []interface{}
+
+	for _, v := range list {
+		isDefault := false
+
+		for _, d := range defaults {
+			if reflect.DeepEqual(v, map[string]interface{}(d)) {
+				isDefault = true
+				break
 			}
 		}
 
-		// Create a mutable copy
-		defCopy, err := copystructure.Copy(def)
-		if err != nil {
-			return err
+		if !isDefault {
+			result = append(result, v)
 		}
+	}
+
+	return result
+}
 
-		definition := reflect.ValueOf(defCopy).Elem()
-		for i := 0; i < definition.NumField(); i++ {
-			sf := definition.Field(i)
+func sortContainerDefinitionList(list []interface{}, key string) {
+	sortKey := func(v interface{}) string {
+		var k string
 
-			// Set all empty slices to nil
-			if sf.Kind() == reflect.Slice {
-				if sf.IsValid() && !sf.IsNil() && sf.Len() == 0 {
-					sf.Set(reflect.Zero(sf.Type()))
+		if elem, ok := v.(map[string]interface{}); ok {
+			k, _ = elem[key].(string)
+		}
+
+		// Elements without a key are ordered by their content.
+		b, _ := json.Marshal(v)
+
+		return k + "\x00" + string(b)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return sortKey(list[i]) < sortKey(list[j])
+	})
+}
+
+// pruneContainerDefinitionEmptyValues removes empty lists and objects, which are equivalent to an omitted field.
+func pruneContainerDefinitionEmptyValues(obj map[string]interface{}) {
+	for k, v := range obj {
+		switch v := v.(type) {
+		case nil:
+			delete(obj, k)
+		case []interface{}:
+			for _, elem := range v {
+				if elem, ok := elem.(map[string]interface{}); ok {
+					pruneContainerDefinitionEmptyValues(elem)
 				}
 			}
+
+			if len(v) == 0 {
+				delete(obj, k)
+			}
+		case map[string]interface{}:
+			pruneContainerDefinitionEmptyValues(v)
+
+			if len(v) == 0 {
+				delete(obj, k)
+			}
+		}
+	}
+}
+
+func containerDefinitionsByName(definitions []interface{}) map[string]interface{} {
+	containers := make(map[string]interface{}, len(definitions))
+
+	for i, v := range definitions {
+		name := fmt.Sprintf("[%d]", i)
+
+		if definition, ok := v.(map[string]interface{}); ok {
+			if v, ok := definition["name"].(string); ok && v != "" {
+				name = v
+			}
 		}
-		iface := definition.Interface().(ecs.ContainerDefinition)
-		cd[i] = &iface
+
+		containers[name] = v
 	}
-	return nil
+
+	return containers
+}
+
+func containerDefinitionDiffPaths(prefix, field string, v1, v2 interface{}) []string {
+	if reflect.DeepEqual(v1, v2) {
+		return nil
+	}
+
+	switch v1 := v1.(type) {
+	case map[string]interface{}:
+		v2, ok := v2.(map[string]interface{})
+
+		if !ok {
+			break
+		}
+
+		var paths []string
+
+		for k, value1 := range v1 {
+			paths = append(paths, containerDefinitionDiffPaths(prefix+"."+k, k, value1, v2[k])...)
+		}
+
+		for k, value2 := range v2 {
+			if _, ok := v1[k]; !ok {
+				paths = append(paths, containerDefinitionDiffPaths(prefix+"."+k, k, nil, value2)...)
+			}
+		}
+
+		return paths
+	case []interface{}:
+		v2, ok := v2.([]interface{})
+
+		if !ok {
+			break
+		}
+
+		if key, ok := containerDefinitionUnorderedLists[field]; ok {
+			if elems1, elems2 := containerDefinitionListByKey(v1, key), containerDefinitionListByKey(v2, key); elems1 != nil && elems2 != nil {
+				return containerDefinitionDiffPaths(prefix, "", elems1, elems2)
+			}
+		}
+
+		if len(v1) != len(v2) {
+			break
+		}
+
+		var paths []string
+
+		for i := range v1 {
+			paths = append(paths, containerDefinitionDiffPaths(fmt.Sprintf("%s[%d]", prefix, i), "", v1[i], v2[i])...)
+		}
+
+		return paths
+	case containerDefinitionKeyedList:
+		v2 := v2.(containerDefinitionKeyedList)
+
+		var paths []string
+
+		for k, value1 := range v1 {
+			paths = append(paths, containerDefinitionDiffPaths(fmt.Sprintf("%s[%s]", prefix, k), "", value1, v2[k])...)
+		}
+
+		for k, value2 := range v2 {
+			if _, ok := v1[k]; !ok {
+				paths = append(paths, containerDefinitionDiffPaths(fmt.Sprintf("%s[%s]", prefix, k), "", nil, value2)...)
+			}
+		}
+
+		return paths
+	}
+
+	return []string{prefix}
+}
+
+// containerDefinitionKeyedList is an unordered list indexed by each element's identifying field.
+type containerDefinitionKeyedList map[string]interface{}
+
+// containerDefinitionListByKey returns nil if any element lacks a unique key.
+func containerDefinitionListByKey(list []interface{}, key string) containerDefinitionKeyedList {
+	elems := make(containerDefinitionKeyedList, len(list))
+
+	for _, v := range list {
+		elem, ok := v.(map[string]interface{})
+
+		if !ok {
+			return nil
+		}
+
+		k, ok := elem[key].(string)
+
+		if !ok || k == "" {
+			return nil
+		}
+
+		if _, ok := elems[k]; ok {
+			return nil
+		}
+
+		elems[k] = elem
+	}
+
+	return elems
 }
 
 func (cd containerDefinitions) OrderEnvironmentVariables() {
@@ -1,6 +1,7 @@
 package ecs_test
 
 import (
+	"reflect"
 	"testing"
 
 	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
@@ -563,3 +564,141 @@ func TestContainerDefinitionsAreEquivalent_missingEnvironmentName(t *testing.T)
 		t.Fatal("Expected definitions to be equal.")
 	}
 }
+
+func TestContainerDefinitionsAreEquivalent_defaults(t *testing.T) {
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "secrets": [
+        {"name": "B", "valueFrom": "arn:aws:ssm:us-east-1:123456789012:parameter/b"},
+        {"name": "A", "valueFrom": "arn:aws:ssm:us-east-1:123456789012:parameter/a"}
+      ],
+      "mountPoints": [
+        {"sourceVolume": "data", "containerPath": "/data"}
+      ],
+      "portMappings": [
+        {"containerPort": 80}
+      ]
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "cpu": 0,
+        "essential": true,
+        "privileged": false,
+        "secrets": [
+          {"name": "A", "valueFrom": "arn:aws:ssm:us-east-1:123456789012:parameter/a"},
+          {"name": "B", "valueFrom": "arn:aws:ssm:us-east-1:123456789012:parameter/b"}
+        ],
+        "mountPoints": [
+          {"sourceVolume": "data", "containerPath": "/data", "readOnly": false}
+        ],
+        "portMappings": [
+          {"containerPort": 80, "hostPort": 80, "protocol": "tcp"}
+        ],
+        "ulimits": [
+          {"name": "nofile", "softLimit": 1024, "hardLimit": 65535}
+        ],
+        "environment": [],
+        "volumesFrom": []
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+
+	// The default ulimits only apply in awsvpc network mode.
+	equal, err = tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if equal {
+		t.Fatal("Expected definitions to differ.")
+	}
+}
+
+func TestContainerDefinitionsDiff(t *testing.T) {
+	def1 := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress:5",
+      "essential": true,
+      "portMappings": [
+        {"containerPort": 80, "hostPort": 80}
+      ]
+    },
+    {
+      "name": "mysql",
+      "image": "mysql",
+      "environment": [
+        {"name": "MYSQL_DATABASE", "value": "wordpress"},
+        {"name": "MYSQL_ROOT_PASSWORD", "value": "password"}
+      ]
+    },
+    {
+      "name": "sidecar",
+      "image": "sidecar"
+    }
+]`
+
+	def2 := `
+[
+    {
+      "name": "mysql",
+      "image": "mysql",
+      "environment": [
+        {"name": "MYSQL_ROOT_PASSWORD", "value": "password2"},
+        {"name": "MYSQL_DATABASE", "value": "wordpress"}
+      ]
+    },
+    {
+      "name": "wordpress",
+      "image": "wordpress:6",
+      "portMappings": [
+        {"containerPort": 80, "hostPort": 8080, "protocol": "tcp"}
+      ]
+    },
+    {
+      "name": "proxy",
+      "image": "proxy"
+    }
+]`
+
+	paths, err := tfecs.ContainerDefinitionsDiff(def1, def2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"mysql.environment[MYSQL_ROOT_PASSWORD].value",
+		"proxy",
+		"sidecar",
+		"wordpress.image",
+		"wordpress.portMappings[0].hostPort",
+	}
+
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("expected %v, got %v", expected, paths)
+	}
+
+	paths, err = tfecs.ContainerDefinitionsDiff(def1, def1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(paths) != 0 {
+		t.Fatalf("expected no differences, got %v", paths)
+	}
+}
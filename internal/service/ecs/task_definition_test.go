@@ -43,6 +43,7 @@ func TestAccECSTaskDefinition_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTaskDefinitionExists(resourceName, &def),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ecs", regexp.MustCompile(`task-definition/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "container_definitions_changes.#", "0"),
 				),
 			},
 			{
@@ -50,6 +51,7 @@ func TestAccECSTaskDefinition_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTaskDefinitionExists(resourceName, &def),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ecs", regexp.MustCompile(`task-definition/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "container_definitions_changes.#", "0"),
 				),
 			},
 			{
@@ -2,11 +2,16 @@ package ecs
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -18,6 +23,12 @@ const (
 	serviceInactiveTimeoutMin = 1 * time.Second
 	serviceDescribeTimeout    = 2 * time.Minute
 	serviceUpdateTimeout      = 2 * time.Minute
+	serviceStableTimeout      = 20 * time.Minute
+	serviceStablePollInterval = 15 * time.Second
+
+	// Number of recent service events and stopped tasks reported when a deployment fails.
+	serviceDeploymentEventsReported       = 5
+	serviceDeploymentStoppedTasksReported = 5
 
 	clusterAvailableTimeout = 10 * time.Minute
 	clusterDeleteTimeout    = 10 * time.Minute
@@ -62,21 +73,293 @@ func waitCapacityProviderUpdated(conn *ecs.ECS, arn string) (*ecs.CapacityProvid
 	return nil, err
 }
 
-func waitServiceStable(conn *ecs.ECS, id, cluster string) error {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{id}),
+// waitServiceStable waits for the service's primary deployment to complete or, if taskDefinition
+// is set, for the deployment of that task definition to become primary and reach its desired count.
+// It fails as soon as the deployment's rollout fails or the deployment is rolled back.
+func waitServiceStable(conn *ecs.ECS, id, cluster, taskDefinition string, timeout time.Duration) (*ecs.Service, error) {
+	monitor := &serviceDeploymentMonitor{
+		conn:           conn,
+		id:             id,
+		cluster:        cluster,
+		taskDefinition: taskDefinition,
+		since:          time.Now(),
 	}
 
-	if cluster != "" {
-		input.Cluster = aws.String(cluster)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{ecs.DeploymentRolloutStateInProgress},
+		Target:       []string{ecs.DeploymentRolloutStateCompleted},
+		Refresh:      statusServiceDeployment(monitor),
+		Timeout:      timeout,
+		PollInterval: serviceStablePollInterval,
 	}
 
-	if err := conn.WaitUntilServicesStable(input); err != nil {
-		return err
+	outputRaw, err := stateConf.WaitForState()
+
+	if tfresource.TimedOut(err) {
+		err = monitor.failure(err)
 	}
+
+	if v, ok := outputRaw.(*ecs.Service); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// serviceDeploymentMonitor follows a single deployment of an ECS service, logging its progress
+// and the service's events, and gathers diagnostics when the deployment fails.
+type serviceDeploymentMonitor struct {
+	conn           *ecs.ECS
+	id             string
+	cluster        string
+	taskDefinition string
+	since          time.Time
+
+	deploymentID string
+	serviceName  string
+	lastEventAt  time.Time
+	events       []*ecs.ServiceEvent
+}
+
+func (m *serviceDeploymentMonitor) refresh() (*ecs.Service, string, error) {
+	service, err := FindServiceByIDAndCluster(m.conn, m.id, m.cluster)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	m.serviceName = aws.StringValue(service.ServiceName)
+	m.recordEvents(service.Events)
+
+	rolloutState, err := m.rolloutState(service)
+
+	if err != nil {
+		return service, rolloutState, m.failure(err)
+	}
+
+	return service, rolloutState, nil
+}
+
+// rolloutState returns the rollout state of the deployment being followed, or an error
+// if the deployment has failed or is no longer active.
+func (m *serviceDeploymentMonitor) rolloutState(service *ecs.Service) (string, error) {
+	deployment := m.deployment(service)
+
+	if deployment == nil {
+		if m.deploymentID != "" {
+			return "", fmt.Errorf("deployment (%s) is no longer active, it has been rolled back or replaced", m.deploymentID)
+		}
+
+		log.Printf("[DEBUG] Waiting for ECS Service (%s) deployment of task definition (%s)", m.id, m.taskDefinition)
+
+		return ecs.DeploymentRolloutStateInProgress, nil
+	}
+
+	m.deploymentID = aws.StringValue(deployment.Id)
+	rolloutState := aws.StringValue(deployment.RolloutState)
+	running, desired := aws.Int64Value(deployment.RunningCount), aws.Int64Value(deployment.DesiredCount)
+
+	log.Printf("[INFO] ECS Service (%s) deployment (%s) of %s is %s: %d running, %d pending, %d desired", m.id, m.deploymentID, aws.StringValue(deployment.TaskDefinition), rolloutState, running, aws.Int64Value(deployment.PendingCount), desired)
+
+	if rolloutState == ecs.DeploymentRolloutStateFailed {
+		return rolloutState, fmt.Errorf("deployment (%s) failed: %s", m.deploymentID, aws.StringValue(deployment.RolloutStateReason))
+	}
+
+	if running != desired {
+		return ecs.DeploymentRolloutStateInProgress, nil
+	}
+
+	if m.taskDefinition != "" {
+		if aws.StringValue(deployment.Status) == serviceDeploymentStatusPrimary {
+			return ecs.DeploymentRolloutStateCompleted, nil
+		}
+
+		return ecs.DeploymentRolloutStateInProgress, nil
+	}
+
+	// Deployments not managed by the ECS deployment controller have no rollout state.
+	if rolloutState == "" {
+		if len(service.Deployments) == 1 && aws.Int64Value(service.RunningCount) == aws.Int64Value(service.DesiredCount) {
+			return ecs.DeploymentRolloutStateCompleted, nil
+		}
+
+		return ecs.DeploymentRolloutStateInProgress, nil
+	}
+
+	return rolloutState, nil
+}
+
+// deployment returns the deployment being followed, or nil if it is not (or no longer) active.
+func (m *serviceDeploymentMonitor) deployment(service *ecs.Service) *ecs.Deployment {
+	for _, deployment := range service.Deployments {
+		if deployment == nil {
+			continue
+		}
+
+		switch {
+		case m.deploymentID != "":
+			if aws.StringValue(deployment.Id) == m.deploymentID {
+				return deployment
+			}
+		case m.taskDefinition != "":
+			if taskDefinitionMatches(aws.StringValue(deployment.TaskDefinition), m.taskDefinition) {
+				return deployment
+			}
+		default:
+			if aws.StringValue(deployment.Status) == serviceDeploymentStatusPrimary {
+				return deployment
+			}
+		}
+	}
+
 	return nil
 }
 
+func (m *serviceDeploymentMonitor) recordEvents(events []*ecs.ServiceEvent) {
+	var latest time.Time
+
+	// Events are returned most recent first.
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+
+		if event == nil {
+			continue
+		}
+
+		createdAt := aws.TimeValue(event.CreatedAt)
+
+		if !createdAt.After(m.since) || !createdAt.After(m.lastEventAt) {
+			continue
+		}
+
+		log.Printf("[INFO] ECS Service (%s) event: %s", m.id, aws.StringValue(event.Message))
+
+		m.events = append(m.events, event)
+		if len(m.events) > serviceDeploymentEventsReported {
+			m.events = m.events[1:]
+		}
+
+		if createdAt.After(latest) {
+			latest = createdAt
+		}
+	}
+
+	if latest.After(m.lastEventAt) {
+		m.lastEventAt = latest
+	}
+}
+
+// failure adds the reasons the deployment's tasks stopped and the most recent service events to err.
+func (m *serviceDeploymentMonitor) failure(err error) error {
+	var sb strings.Builder
+
+	if reasons := m.stoppedTaskReasons(); len(reasons) > 0 {
+		sb.WriteString("\n\nStopped tasks:")
+
+		for _, reason := range reasons {
+			sb.WriteString("\n  - " + reason)
+		}
+	}
+
+	if len(m.events) > 0 {
+		sb.WriteString("\n\nRecent service events:")
+
+		for _, event := range m.events {
+			fmt.Fprintf(&sb, "\n  - %s %s", aws.TimeValue(event.CreatedAt).Format(time.RFC3339), aws.StringValue(event.Message))
+		}
+	}
+
+	return fmt.Errorf("%w%s", err, sb.String())
+}
+
+func (m *serviceDeploymentMonitor) stoppedTaskReasons() []string {
+	if m.serviceName == "" {
+		return nil
+	}
+
+	input := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		ServiceName:   aws.String(m.serviceName),
+	}
+
+	if m.cluster != "" {
+		input.Cluster = aws.String(m.cluster)
+	}
+
+	output, err := m.conn.ListTasks(input)
+
+	if err != nil || output == nil || len(output.TaskArns) == 0 {
+		if err != nil {
+			log.Printf("[WARN] Listing stopped tasks for ECS Service (%s): %s", m.id, err)
+		}
+
+		return nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Tasks: output.TaskArns,
+	}
+
+	if m.cluster != "" {
+		describeInput.Cluster = aws.String(m.cluster)
+	}
+
+	describeOutput, err := m.conn.DescribeTasks(describeInput)
+
+	if err != nil || describeOutput == nil {
+		if err != nil {
+			log.Printf("[WARN] Describing stopped tasks for ECS Service (%s): %s", m.id, err)
+		}
+
+		return nil
+	}
+
+	var tasks []*ecs.Task
+
+	for _, task := range describeOutput.Tasks {
+		if task == nil || aws.TimeValue(task.StoppedAt).Before(m.since) {
+			continue
+		}
+
+		// Service tasks are started by their deployment.
+		if m.deploymentID != "" && aws.StringValue(task.StartedBy) != m.deploymentID {
+			continue
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return aws.TimeValue(tasks[i].StoppedAt).After(aws.TimeValue(tasks[j].StoppedAt))
+	})
+
+	var reasons []string
+
+	for _, task := range tasks {
+		if len(reasons) == serviceDeploymentStoppedTasksReported {
+			break
+		}
+
+		reason := fmt.Sprintf("%s: %s", aws.StringValue(task.TaskArn), aws.StringValue(task.StoppedReason))
+
+		for _, container := range task.Containers {
+			if v := aws.StringValue(container.Reason); v != "" {
+				reason += fmt.Sprintf(" (%s: %s)", aws.StringValue(container.Name), v)
+			}
+		}
+
+		reasons = append(reasons, reason)
+	}
+
+	return reasons
+}
+
+// taskDefinitionMatches returns whether a task definition ARN refers to the task definition
+// specified either by ARN or as family:revision.
+func taskDefinitionMatches(arn, taskDefinition string) bool {
+	return arn == taskDefinition || strings.HasSuffix(arn, "task-definition/"+taskDefinition)
+}
+
 func waitServiceInactive(conn *ecs.ECS, id, cluster string) error {
 	input := &ecs.DescribeServicesInput{
 		Services: aws.StringSlice([]string{id}),
@@ -0,0 +1,191 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestServiceDeploymentMonitorRolloutState(t *testing.T) {
+	deployment := func(id, status, rolloutState, taskDefinition string, running, desired int64) *ecs.Deployment {
+		return &ecs.Deployment{
+			DesiredCount:       aws.Int64(desired),
+			Id:                 aws.String(id),
+			RolloutState:       aws.String(rolloutState),
+			RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."),
+			RunningCount:       aws.Int64(running),
+			Status:             aws.String(status),
+			TaskDefinition:     aws.String(taskDefinition),
+		}
+	}
+	service := func(running, desired int64, deployments ...*ecs.Deployment) *ecs.Service {
+		return &ecs.Service{
+			Deployments:  deployments,
+			DesiredCount: aws.Int64(desired),
+			RunningCount: aws.Int64(running),
+		}
+	}
+
+	type step struct {
+		Service       *ecs.Service
+		ExpectedState string
+		ExpectedError bool
+	}
+
+	testCases := []struct {
+		Name           string
+		TaskDefinition string
+		Steps          []step
+	}{
+		{
+			Name: "in progress to completed",
+			Steps: []step{
+				{
+					Service: service(1, 2,
+						deployment("ecs-svc/2", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:2", 0, 2),
+						deployment("ecs-svc/1", "ACTIVE", ecs.DeploymentRolloutStateCompleted, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:1", 1, 1),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateInProgress,
+				},
+				{
+					Service: service(2, 2,
+						deployment("ecs-svc/2", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:2", 2, 2),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateInProgress,
+				},
+				{
+					Service: service(2, 2,
+						deployment("ecs-svc/2", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateCompleted, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:2", 2, 2),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateCompleted,
+				},
+			},
+		},
+		{
+			Name: "in progress to failed",
+			Steps: []step{
+				{
+					Service: service(0, 2,
+						deployment("ecs-svc/2", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, "web:2", 0, 2),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateInProgress,
+				},
+				{
+					Service: service(0, 2,
+						deployment("ecs-svc/2", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateFailed, "web:2", 0, 2),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateFailed,
+					ExpectedError: true,
+				},
+			},
+		},
+		{
+			Name: "rolled back by circuit breaker",
+			Steps: []step{
+				{
+					Service: service(1, 1,
+						deployment("ecs-svc/2", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, "web:2", 0, 1),
+						deployment("ecs-svc/1", "ACTIVE", ecs.DeploymentRolloutStateCompleted, "web:1", 1, 1),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateInProgress,
+				},
+				{
+					// The rollback starts a new primary deployment of the previous task definition.
+					Service: service(1, 1,
+						deployment("ecs-svc/3", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, "web:1", 1, 1),
+					),
+					ExpectedError: true,
+				},
+			},
+		},
+		{
+			Name: "no rollout state",
+			Steps: []step{
+				{
+					Service: service(2, 2,
+						deployment("ecs-svc/2", serviceDeploymentStatusPrimary, "", "web:2", 2, 2),
+						deployment("ecs-svc/1", "ACTIVE", "", "web:1", 1, 1),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateInProgress,
+				},
+				{
+					Service: service(2, 2,
+						deployment("ecs-svc/2", serviceDeploymentStatusPrimary, "", "web:2", 2, 2),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateCompleted,
+				},
+			},
+		},
+		{
+			Name:           "task definition revision",
+			TaskDefinition: "web:2",
+			Steps: []step{
+				{
+					// The new deployment hasn't been created yet.
+					Service: service(1, 1,
+						deployment("ecs-svc/1", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateCompleted, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:1", 1, 1),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateInProgress,
+				},
+				{
+					Service: service(1, 1,
+						deployment("ecs-svc/2", "ACTIVE", ecs.DeploymentRolloutStateInProgress, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:2", 1, 1),
+						deployment("ecs-svc/1", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateCompleted, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:1", 1, 1),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateInProgress,
+				},
+				{
+					Service: service(1, 1,
+						deployment("ecs-svc/2", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:2", 1, 1),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateCompleted,
+				},
+			},
+		},
+		{
+			Name:           "task definition revision rolled back",
+			TaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/web:2",
+			Steps: []step{
+				{
+					Service: service(1, 1,
+						deployment("ecs-svc/2", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateInProgress, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:2", 0, 1),
+						deployment("ecs-svc/1", "ACTIVE", ecs.DeploymentRolloutStateCompleted, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:1", 1, 1),
+					),
+					ExpectedState: ecs.DeploymentRolloutStateInProgress,
+				},
+				{
+					Service: service(1, 1,
+						deployment("ecs-svc/1", serviceDeploymentStatusPrimary, ecs.DeploymentRolloutStateCompleted, "arn:aws:ecs:us-west-2:123456789012:task-definition/web:1", 1, 1),
+					),
+					ExpectedError: true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			monitor := &serviceDeploymentMonitor{
+				id:             "web",
+				taskDefinition: testCase.TaskDefinition,
+			}
+
+			for i, step := range testCase.Steps {
+				got, err := monitor.rolloutState(step.Service)
+
+				if err == nil && step.ExpectedError {
+					t.Fatalf("step %d: expected error", i)
+				}
+
+				if err != nil && !step.ExpectedError {
+					t.Fatalf("step %d: unexpected error: %s", i, err)
+				}
+
+				if got != step.ExpectedState {
+					t.Errorf("step %d: expected state %q, got %q", i, step.ExpectedState, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+package eks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	kubeconfigExecAPIVersionDefault = "client.authentication.k8s.io/v1beta1"
+	kubeconfigExecCommandDefault    = "aws"
+)
+
+func DataSourceKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_authority_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"context_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exec_args": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"token": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"current_context": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"exec": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  kubeconfigExecAPIVersionDefault,
+						},
+						"command": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  kubeconfigExecCommandDefault,
+						},
+						"env": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EKSConn
+
+	var exec *KubeconfigExec
+
+	if v, ok := d.GetOk("exec"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		exec = &KubeconfigExec{
+			APIVersion: tfMap["api_version"].(string),
+			Command:    tfMap["command"].(string),
+			Env:        aws.StringValueMap(flex.ExpandStringMap(tfMap["env"].(map[string]interface{}))),
+			Region:     tfMap["region"].(string),
+			RoleARN:    tfMap["role_arn"].(string),
+		}
+
+		if exec.Region == "" {
+			exec.Region = meta.(*conns.AWSClient).Region
+		}
+	}
+
+	var generator Generator
+
+	if exec == nil {
+		var err error
+		generator, err = NewGenerator(false, false)
+
+		if err != nil {
+			return fmt.Errorf("error getting token generator: %w", err)
+		}
+	}
+
+	var clusters []KubeconfigCluster
+	var names []string
+	var tfList []interface{}
+
+	for _, v := range d.Get("cluster").([]interface{}) {
+		tfMap := v.(map[string]interface{})
+		name := tfMap["name"].(string)
+
+		cluster, err := FindClusterByName(conn, name)
+
+		if err != nil {
+			return fmt.Errorf("error reading EKS Cluster (%s): %w", name, err)
+		}
+
+		kubeconfigCluster := KubeconfigCluster{
+			ARN:         aws.StringValue(cluster.Arn),
+			ContextName: tfMap["context_name"].(string),
+			Endpoint:    aws.StringValue(cluster.Endpoint),
+			Exec:        exec,
+			Name:        name,
+		}
+
+		if cluster.CertificateAuthority != nil {
+			kubeconfigCluster.CertificateAuthorityData = aws.StringValue(cluster.CertificateAuthority.Data)
+		}
+
+		if kubeconfigCluster.ContextName == "" {
+			kubeconfigCluster.ContextName = name
+		}
+
+		if generator != nil {
+			token, err := generator.GetWithSTS(name, meta.(*conns.AWSClient).STSConn)
+
+			if err != nil {
+				return fmt.Errorf("error getting token for EKS Cluster (%s): %w", name, err)
+			}
+
+			kubeconfigCluster.Token = token.Token
+		}
+
+		clusters = append(clusters, kubeconfigCluster)
+		names = append(names, name)
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                        kubeconfigCluster.ARN,
+			"certificate_authority_data": kubeconfigCluster.CertificateAuthorityData,
+			"context_name":               kubeconfigCluster.ContextName,
+			"endpoint":                   kubeconfigCluster.Endpoint,
+			"exec_args":                  kubeconfigCluster.ExecArgs(),
+			"name":                       name,
+			"token":                      kubeconfigCluster.Token,
+		})
+	}
+
+	currentContext := d.Get("current_context").(string)
+
+	if currentContext == "" {
+		currentContext = clusters[0].ContextName
+	}
+
+	kubeconfig, err := RenderKubeconfig(clusters, currentContext)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strings.Join(names, ","))
+
+	if err := d.Set("cluster", tfList); err != nil {
+		return fmt.Errorf("error setting cluster: %w", err)
+	}
+
+	d.Set("current_context", currentContext)
+	d.Set("kubeconfig", kubeconfig)
+
+	return nil
+}
+
+// KubeconfigCluster is an EKS cluster and the credentials used to access it.
+// Either Token or Exec is set.
+type KubeconfigCluster struct {
+	ARN                      string
+	CertificateAuthorityData string
+	ContextName              string
+	Endpoint                 string
+	Exec                     *KubeconfigExec
+	Name                     string
+	Token                    string
+}
+
+// KubeconfigExec configures a credential plugin that obtains a token with "aws eks get-token".
+type KubeconfigExec struct {
+	APIVersion string
+	Command    string
+	Env        map[string]string
+	Region     string
+	RoleARN    string
+}
+
+// ExecArgs returns the arguments passed to the credential plugin, or nil if a static token is used.
+func (c KubeconfigCluster) ExecArgs() []string {
+	if c.Exec == nil {
+		return nil
+	}
+
+	args := []string{}
+
+	if c.Exec.Region != "" {
+		args = append(args, "--region", c.Exec.Region)
+	}
+
+	args = append(args, "eks", "get-token", "--cluster-name", c.Name)
+
+	if c.Exec.RoleARN != "" {
+		args = append(args, "--role-arn", c.Exec.RoleARN)
+	}
+
+	return args
+}
+
+type kubeconfigDocument struct {
+	APIVersion     string                 `yaml:"apiVersion"`
+	Kind           string                 `yaml:"kind"`
+	Clusters       []kubeconfigNamedEntry `yaml:"clusters"`
+	Contexts       []kubeconfigNamedEntry `yaml:"contexts"`
+	CurrentContext string                 `yaml:"current-context"`
+	Preferences    map[string]interface{} `yaml:"preferences"`
+	Users          []kubeconfigNamedEntry `yaml:"users"`
+}
+
+type kubeconfigNamedEntry struct {
+	Name    string      `yaml:"name"`
+	Cluster interface{} `yaml:"cluster,omitempty"`
+	Context interface{} `yaml:"context,omitempty"`
+	User    interface{} `yaml:"user,omitempty"`
+}
+
+type kubeconfigClusterEntry struct {
+	CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
+	Server                   string `yaml:"server"`
+}
+
+type kubeconfigContextEntry struct {
+	Cluster string `yaml:"cluster"`
+	User    string `yaml:"user"`
+}
+
+type kubeconfigUserEntry struct {
+	Exec  *kubeconfigExecEntry `yaml:"exec,omitempty"`
+	Token string               `yaml:"token,omitempty"`
+}
+
+type kubeconfigExecEntry struct {
+	APIVersion string               `yaml:"apiVersion"`
+	Command    string               `yaml:"command"`
+	Args       []string             `yaml:"args"`
+	Env        []kubeconfigEnvEntry `yaml:"env,omitempty"`
+}
+
+type kubeconfigEnvEntry struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// RenderKubeconfig renders a kubeconfig document with a cluster, user and context for each cluster.
+// Cluster and user entries are named by cluster ARN, as by "aws eks update-kubeconfig".
+func RenderKubeconfig(clusters []KubeconfigCluster, currentContext string) (string, error) {
+	config := kubeconfigDocument{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		CurrentContext: currentContext,
+		Preferences:    map[string]interface{}{},
+	}
+
+	contextNames := make(map[string]bool)
+
+	for _, cluster := range clusters {
+		if contextNames[cluster.ContextName] {
+			return "", fmt.Errorf("duplicate kubeconfig context name: %s", cluster.ContextName)
+		}
+
+		contextNames[cluster.ContextName] = true
+
+		config.Clusters = append(config.Clusters, kubeconfigNamedEntry{
+			Name: cluster.ARN,
+			Cluster: kubeconfigClusterEntry{
+				CertificateAuthorityData: cluster.CertificateAuthorityData,
+				Server:                   cluster.Endpoint,
+			},
+		})
+
+		config.Contexts = append(config.Contexts, kubeconfigNamedEntry{
+			Name: cluster.ContextName,
+			Context: kubeconfigContextEntry{
+				Cluster: cluster.ARN,
+				User:    cluster.ARN,
+			},
+		})
+
+		user := kubeconfigUserEntry{
+			Token: cluster.Token,
+		}
+
+		if cluster.Exec != nil {
+			user.Exec = &kubeconfigExecEntry{
+				APIVersion: cluster.Exec.APIVersion,
+				Command:    cluster.Exec.Command,
+				Args:       cluster.ExecArgs(),
+			}
+
+			var names []string
+			for name := range cluster.Exec.Env {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				user.Exec.Env = append(user.Exec.Env, kubeconfigEnvEntry{
+					Name:  name,
+					Value: cluster.Exec.Env[name],
+				})
+			}
+		}
+
+		config.Users = append(config.Users, kubeconfigNamedEntry{
+			Name: cluster.ARN,
+			User: user,
+		})
+	}
+
+	if !contextNames[currentContext] {
+		return "", fmt.Errorf("current context (%s) is not the context of any cluster", currentContext)
+	}
+
+	b, err := yaml.Marshal(config)
+
+	if err != nil {
+		return "", fmt.Errorf("error rendering kubeconfig: %w", err)
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,169 @@
+package eks_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+)
+
+func TestRenderKubeconfig(t *testing.T) {
+	clusters := []tfeks.KubeconfigCluster{
+		{
+			ARN:                      "arn:aws:eks:us-west-2:123456789012:cluster/one",
+			CertificateAuthorityData: "Q0E=",
+			ContextName:              "one",
+			Endpoint:                 "https://one.example.com",
+			Name:                     "one",
+			Token:                    "k8s-aws-v1.token",
+		},
+		{
+			ARN:         "arn:aws:eks:us-west-2:123456789012:cluster/two",
+			ContextName: "two",
+			Endpoint:    "https://two.example.com",
+			Exec: &tfeks.KubeconfigExec{
+				APIVersion: "client.authentication.k8s.io/v1beta1",
+				Command:    "aws",
+				Env:        map[string]string{"AWS_PROFILE": "example"},
+				Region:     "us-west-2",
+				RoleARN:    "arn:aws:iam::123456789012:role/example",
+			},
+			Name: "two",
+		},
+	}
+
+	got, err := tfeks.RenderKubeconfig(clusters, "two")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `apiVersion: v1
+kind: Config
+clusters:
+- name: arn:aws:eks:us-west-2:123456789012:cluster/one
+  cluster:
+    certificate-authority-data: Q0E=
+    server: https://one.example.com
+- name: arn:aws:eks:us-west-2:123456789012:cluster/two
+  cluster:
+    server: https://two.example.com
+contexts:
+- name: one
+  context:
+    cluster: arn:aws:eks:us-west-2:123456789012:cluster/one
+    user: arn:aws:eks:us-west-2:123456789012:cluster/one
+- name: two
+  context:
+    cluster: arn:aws:eks:us-west-2:123456789012:cluster/two
+    user: arn:aws:eks:us-west-2:123456789012:cluster/two
+current-context: two
+preferences: {}
+users:
+- name: arn:aws:eks:us-west-2:123456789012:cluster/one
+  user:
+    token: k8s-aws-v1.token
+- name: arn:aws:eks:us-west-2:123456789012:cluster/two
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+      args:
+      - --region
+      - us-west-2
+      - eks
+      - get-token
+      - --cluster-name
+      - two
+      - --role-arn
+      - arn:aws:iam::123456789012:role/example
+      env:
+      - name: AWS_PROFILE
+        value: example
+`
+
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	if _, err := tfeks.RenderKubeconfig(clusters, "three"); err == nil {
+		t.Error("expected error for unknown current context")
+	}
+
+	clusters[1].ContextName = "one"
+
+	if _, err := tfeks.RenderKubeconfig(clusters, "one"); err == nil {
+		t.Error("expected error for duplicate context name")
+	}
+}
+
+func TestAccEKSKubeconfigDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_kubeconfig.test"
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eks.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceResourceName, "cluster.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceResourceName, "cluster.0.arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint", dataSourceResourceName, "cluster.0.endpoint"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority.0.data", dataSourceResourceName, "cluster.0.certificate_authority_data"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "cluster.0.context_name", rName),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "cluster.0.token"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "cluster.0.exec_args.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "current_context", rName),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`token: k8s-aws-v1\.`)),
+				),
+			},
+			{
+				Config: testAccKubeconfigDataSourceConfig_Exec(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceResourceName, "cluster.0.context_name", "example"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "cluster.0.token", ""),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "cluster.0.exec_args.#", "6"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "cluster.0.exec_args.5", rName),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "current_context", "example"),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`command: aws`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubeconfigDataSourceConfig_Basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_Required(rName), `
+data "aws_eks_kubeconfig" "test" {
+  cluster {
+    name = aws_eks_cluster.test.name
+  }
+}
+`)
+}
+
+func testAccKubeconfigDataSourceConfig_Exec(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_Required(rName), `
+data "aws_region" "current" {}
+
+data "aws_eks_kubeconfig" "test" {
+  cluster {
+    name         = aws_eks_cluster.test.name
+    context_name = "example"
+  }
+
+  exec {
+    region = data.aws_region.current.name
+  }
+}
+`)
+}
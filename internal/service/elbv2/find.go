@@ -92,3 +92,35 @@ func FindTargetGroupByARN(conn *elbv2.ELBV2, arn string) (*elbv2.TargetGroup, er
 
 	return nil, nil
 }
+
+func FindListenerRulesByListenerARN(conn *elbv2.ELBV2, arn string) ([]*elbv2.Rule, error) {
+	input := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(arn),
+	}
+
+	var result []*elbv2.Rule
+
+	for {
+		output, err := conn.DescribeRules(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range output.Rules {
+			if rule == nil {
+				continue
+			}
+
+			result = append(result, rule)
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+
+		input.Marker = output.NextMarker
+	}
+
+	return result, nil
+}
@@ -1,6 +1,7 @@
 package elbv2
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -55,11 +56,37 @@ func ResourceListenerRule() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"priority": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validListenerRulePriority,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validListenerRulePriority,
+				ConflictsWith: []string{"priority_after", "priority_band"},
+			},
+			"priority_after": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  verify.ValidARN,
+				ConflictsWith: []string{"priority"},
+			},
+			"priority_band": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"priority"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(listenerRulePriorityMin, listenerRulePriorityMax),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(listenerRulePriorityMin, listenerRulePriorityMax),
+						},
+					},
+				},
 			},
 			"action": {
 				Type:     schema.TypeList,
@@ -466,6 +493,7 @@ func ResourceListenerRule() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			resourceListenerRuleCustomizeDiffPriority,
 		),
 	}
 }
@@ -793,7 +821,13 @@ func resourceListenerRuleRead(d *schema.ResourceData, meta interface{}) error {
 func resourceListenerRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ELBV2Conn
 
-	if d.HasChange("priority") {
+	if hasListenerRulePriorityConstraints(d) {
+		if d.HasChanges("priority", "priority_after", "priority_band") {
+			if err := reorderListenerRule(conn, d); err != nil {
+				return fmt.Errorf("error setting LB Listener Rule (%s) priority: %w", d.Id(), err)
+			}
+		}
+	} else if d.HasChange("priority") {
 		params := &elbv2.SetRulePrioritiesInput{
 			RulePriorities: []*elbv2.RulePriorityPair{
 				{
@@ -803,7 +837,11 @@ func resourceListenerRuleUpdate(d *schema.ResourceData, meta interface{}) error
 			},
 		}
 
+		mutexKey := listenerRulePriorityMutexKey(d.Get("listener_arn").(string))
+		conns.GlobalMutexKV.Lock(mutexKey)
 		_, err := conn.SetRulePriorities(params)
+		conns.GlobalMutexKV.Unlock(mutexKey)
+
 		if err != nil {
 			return err
 		}
@@ -894,14 +932,59 @@ func resourceListenerRuleDelete(d *schema.ResourceData, meta interface{}) error
 func retryListenerRuleCreate(conn *elbv2.ELBV2, d *schema.ResourceData, params *elbv2.CreateRuleInput, listenerARN string) (*elbv2.CreateRuleOutput, error) {
 	var resp *elbv2.CreateRuleOutput
 	if v, ok := d.GetOk("priority"); ok {
+		// Serialize with rules that are moved to make room for priority_after and priority_band.
+		mutexKey := listenerRulePriorityMutexKey(listenerARN)
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 		var err error
 		params.Priority = aws.Int64(int64(v.(int)))
 		resp, err = conn.CreateRule(params)
 
+		if err != nil {
+			return nil, err
+		}
+	} else if hasListenerRulePriorityConstraints(d) {
+		mutexKey := listenerRulePriorityMutexKey(listenerARN)
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			priority, moves, err := allocateListenerRulePriority(conn, d, listenerARN, "")
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			// Make room for the new rule before creating it.
+			if len(moves) > 0 {
+				if err := setListenerRulePriorities(conn, moves); err != nil {
+					return resource.NonRetryableError(err)
+				}
+			}
+
+			params.Priority = aws.Int64(priority)
+			resp, err = conn.CreateRule(params)
+			if err != nil {
+				if tfawserr.ErrCodeEquals(err, elbv2.ErrCodePriorityInUseException) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			return nil, fmt.Errorf("timeout allocating LB Listener Rule (%s) priority", listenerARN)
+		}
+
 		if err != nil {
 			return nil, err
 		}
 	} else {
+		mutexKey := listenerRulePriorityMutexKey(listenerARN)
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 		var priority int64
 
 		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -968,26 +1051,16 @@ func ListenerARNFromRuleARN(ruleArn string) string {
 
 func highestListenerRulePriority(conn *elbv2.ELBV2, arn string) (priority int64, err error) {
 	var priorities []int
-	var nextMarker *string
 
-	for {
-		out, aerr := conn.DescribeRules(&elbv2.DescribeRulesInput{
-			ListenerArn: aws.String(arn),
-			Marker:      nextMarker,
-		})
-		if aerr != nil {
-			return 0, aerr
-		}
-		for _, rule := range out.Rules {
-			if aws.StringValue(rule.Priority) != "default" {
-				p, _ := strconv.Atoi(aws.StringValue(rule.Priority))
-				priorities = append(priorities, p)
-			}
-		}
-		if out.NextMarker == nil {
-			break
+	rules, err := FindListenerRulesByListenerARN(conn, arn)
+	if err != nil {
+		return 0, err
+	}
+	for _, rule := range rules {
+		if aws.StringValue(rule.Priority) != "default" {
+			p, _ := strconv.Atoi(aws.StringValue(rule.Priority))
+			priorities = append(priorities, p)
 		}
-		nextMarker = out.NextMarker
 	}
 
 	if len(priorities) == 0 {
@@ -999,6 +1072,241 @@ func highestListenerRulePriority(conn *elbv2.ELBV2, arn string) (priority int64,
 	return int64(priorities[len(priorities)-1]), nil
 }
 
+// hasListenerRulePriorityConstraints returns whether the rule's priority is allocated from its relative ordering.
+func hasListenerRulePriorityConstraints(d interface{ Get(string) interface{} }) bool {
+	return d.Get("priority_after").(string) != "" || len(d.Get("priority_band").([]interface{})) > 0
+}
+
+func listenerRulePriorityMutexKey(listenerARN string) string {
+	return "elbv2_listener_rule_priority_" + listenerARN
+}
+
+// listenerRulePriorityRange returns the range of priorities that satisfy the rule's priority_after and priority_band
+// arguments given the listener's current rules, and whether the rule must be evaluated directly after another rule.
+func listenerRulePriorityRange(d interface{ Get(string) interface{} }, rules []*elbv2.Rule) (int64, int64, bool, error) {
+	lower, upper := int64(listenerRulePriorityMin), int64(listenerRulePriorityMax)
+	after := false
+
+	if v, ok := d.Get("priority_band").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		lower, upper = int64(tfMap["min"].(int)), int64(tfMap["max"].(int))
+
+		if lower > upper {
+			return 0, 0, false, fmt.Errorf("priority_band min (%d) must not be greater than max (%d)", lower, upper)
+		}
+	}
+
+	if v := d.Get("priority_after").(string); v != "" {
+		var rule *elbv2.Rule
+
+		for _, r := range rules {
+			if aws.StringValue(r.RuleArn) == v {
+				rule = r
+				break
+			}
+		}
+
+		if rule == nil {
+			return 0, 0, false, fmt.Errorf("rule (%s) not found on listener", v)
+		}
+
+		if aws.BoolValue(rule.IsDefault) {
+			return 0, 0, false, fmt.Errorf("rule (%s) is the listener's default rule, which is always evaluated last", v)
+		}
+
+		priority, err := strconv.ParseInt(aws.StringValue(rule.Priority), 10, 64)
+		if err != nil {
+			return 0, 0, false, fmt.Errorf("converting rule (%s) priority %q to int: %w", v, aws.StringValue(rule.Priority), err)
+		}
+
+		if priority+1 > lower {
+			lower = priority + 1
+		}
+		after = true
+	}
+
+	if lower > upper {
+		return 0, 0, false, fmt.Errorf("no priority satisfies the rule's priority_after and priority_band (%d-%d)", lower, upper)
+	}
+
+	return lower, upper, after, nil
+}
+
+// allocateListenerRulePriority allocates a priority for the rule with the specified ARN (empty for a new rule) and
+// returns any priority changes to other rules on the listener needed to make room for it.
+func allocateListenerRulePriority(conn *elbv2.ELBV2, d interface{ Get(string) interface{} }, listenerARN, ruleARN string) (int64, map[string]int64, error) {
+	rules, err := FindListenerRulesByListenerARN(conn, listenerARN)
+	if err != nil {
+		return 0, nil, fmt.Errorf("reading LB Listener (%s) rules: %w", listenerARN, err)
+	}
+
+	lower, upper, after, err := listenerRulePriorityRange(d, rules)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	priorities := make(map[int64]string)
+	for _, rule := range rules {
+		if aws.BoolValue(rule.IsDefault) {
+			continue
+		}
+
+		priority, err := strconv.ParseInt(aws.StringValue(rule.Priority), 10, 64)
+		if err != nil {
+			return 0, nil, fmt.Errorf("converting rule (%s) priority %q to int: %w", aws.StringValue(rule.RuleArn), aws.StringValue(rule.Priority), err)
+		}
+
+		priorities[priority] = aws.StringValue(rule.RuleArn)
+	}
+
+	return AllocateListenerRulePriority(priorities, ruleARN, lower, upper, after)
+}
+
+// AllocateListenerRulePriority allocates a priority in the range lower-upper for the rule with the specified ARN
+// (empty for a new rule) given the listener's current rule priorities.
+// The rule's current priority is kept if it is in range.
+// If insert is true the rule is placed at the lowest priority in range, with any rules occupying that and consecutive
+// priorities each moved up by one, keeping their relative order. Otherwise the lowest unused priority in range is used.
+// The returned map contains the new priorities of the other rules that must be moved.
+func AllocateListenerRulePriority(priorities map[int64]string, ruleARN string, lower, upper int64, insert bool) (int64, map[string]int64, error) {
+	for priority, arn := range priorities {
+		if ruleARN != "" && arn == ruleARN {
+			if priority >= lower && priority <= upper {
+				return priority, nil, nil
+			}
+
+			delete(priorities, priority)
+			break
+		}
+	}
+
+	if !insert {
+		for priority := lower; priority <= upper; priority++ {
+			if _, ok := priorities[priority]; !ok {
+				return priority, nil, nil
+			}
+		}
+
+		return 0, nil, fmt.Errorf("no unused priority in the range %d-%d", lower, upper)
+	}
+
+	free := lower
+	for ; free <= upper; free++ {
+		if _, ok := priorities[free]; !ok {
+			break
+		}
+	}
+
+	if free > upper {
+		return 0, nil, fmt.Errorf("no unused priority in the range %d-%d", lower, upper)
+	}
+
+	moves := make(map[string]int64)
+	for priority := lower; priority < free; priority++ {
+		moves[priorities[priority]] = priority + 1
+	}
+
+	return lower, moves, nil
+}
+
+func setListenerRulePriorities(conn *elbv2.ELBV2, priorities map[string]int64) error {
+	input := &elbv2.SetRulePrioritiesInput{}
+
+	// Sort for deterministic requests.
+	arns := make([]string, 0, len(priorities))
+	for arn := range priorities {
+		arns = append(arns, arn)
+	}
+	sort.Strings(arns)
+
+	for _, arn := range arns {
+		input.RulePriorities = append(input.RulePriorities, &elbv2.RulePriorityPair{
+			Priority: aws.Int64(priorities[arn]),
+			RuleArn:  aws.String(arn),
+		})
+	}
+
+	log.Printf("[DEBUG] Setting LB Listener Rule priorities: %s", input)
+	_, err := conn.SetRulePriorities(input)
+
+	return err
+}
+
+// reorderListenerRule moves an existing rule, and any rules that must make room for it, to satisfy its
+// priority_after and priority_band arguments. All priorities are changed in a single request.
+func reorderListenerRule(conn *elbv2.ELBV2, d *schema.ResourceData) error {
+	listenerARN := d.Get("listener_arn").(string)
+	mutexKey := listenerRulePriorityMutexKey(listenerARN)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		priority, moves, err := allocateListenerRulePriority(conn, d, listenerARN, d.Id())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if len(moves) == 0 && priority == int64(d.Get("priority").(int)) {
+			return nil
+		}
+
+		if moves == nil {
+			moves = make(map[string]int64)
+		}
+		moves[d.Id()] = priority
+
+		err = setListenerRulePriorities(conn, moves)
+		if tfawserr.ErrCodeEquals(err, elbv2.ErrCodePriorityInUseException) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		return fmt.Errorf("timeout allocating priority")
+	}
+
+	return err
+}
+
+// resourceListenerRuleCustomizeDiffPriority plans a new priority for an existing rule whose priority no longer
+// satisfies its priority_after and priority_band arguments, e.g. because the rule it follows has moved.
+func resourceListenerRuleCustomizeDiffPriority(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !hasListenerRulePriorityConstraints(diff) {
+		return nil
+	}
+
+	if diff.HasChanges("priority_after", "priority_band") {
+		return diff.SetNewComputed("priority")
+	}
+
+	if !diff.NewValueKnown("priority_after") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ELBV2Conn
+	listenerARN := diff.Get("listener_arn").(string)
+
+	rules, err := FindListenerRulesByListenerARN(conn, listenerARN)
+	if err != nil {
+		return fmt.Errorf("error reading LB Listener (%s) rules: %w", listenerARN, err)
+	}
+
+	lower, upper, _, err := listenerRulePriorityRange(diff, rules)
+	if err != nil {
+		return err
+	}
+
+	if priority := int64(diff.Get("priority").(int)); priority < lower || priority > upper {
+		return diff.SetNewComputed("priority")
+	}
+
+	return nil
+}
+
 // lbListenerRuleConditions converts data source generated by Terraform into
 // an elbv2.RuleCondition object suitable for submitting to AWS API.
 func lbListenerRuleConditions(conditions []interface{}) ([]*elbv2.RuleCondition, error) {
@@ -59,6 +59,126 @@ func TestLBListenerARNFromRuleARN(t *testing.T) {
 	}
 }
 
+func TestAllocateListenerRulePriority(t *testing.T) {
+	cases := []struct {
+		name       string
+		priorities map[int64]string
+		ruleARN    string
+		lower      int64
+		upper      int64
+		insert     bool
+		expected   int64
+		moves      map[string]int64
+		expectErr  bool
+	}{
+		{
+			name:       "lowest unused",
+			priorities: map[int64]string{1: "a", 2: "b", 4: "c"},
+			lower:      1,
+			upper:      10,
+			expected:   3,
+		},
+		{
+			name:       "band",
+			priorities: map[int64]string{1: "a", 100: "b", 101: "c"},
+			lower:      100,
+			upper:      199,
+			expected:   102,
+		},
+		{
+			name:       "band full",
+			priorities: map[int64]string{100: "a", 101: "b"},
+			lower:      100,
+			upper:      101,
+			expectErr:  true,
+		},
+		{
+			name:       "keep current",
+			priorities: map[int64]string{1: "a", 5: "b"},
+			ruleARN:    "b",
+			lower:      2,
+			upper:      10,
+			expected:   5,
+		},
+		{
+			name:       "move current",
+			priorities: map[int64]string{1: "a", 5: "b"},
+			ruleARN:    "a",
+			lower:      2,
+			upper:      10,
+			expected:   2,
+		},
+		{
+			name:       "insert unused",
+			priorities: map[int64]string{1: "a", 5: "b"},
+			lower:      2,
+			upper:      50000,
+			insert:     true,
+			expected:   2,
+			moves:      map[string]int64{},
+		},
+		{
+			name:       "insert shifts",
+			priorities: map[int64]string{1: "a", 2: "b", 3: "c", 5: "d"},
+			lower:      2,
+			upper:      50000,
+			insert:     true,
+			expected:   2,
+			moves:      map[string]int64{"b": 3, "c": 4},
+		},
+		{
+			name:       "insert existing rule",
+			priorities: map[int64]string{1: "a", 2: "b", 3: "c", 4: "d"},
+			ruleARN:    "d",
+			lower:      2,
+			upper:      3,
+			insert:     true,
+			expectErr:  true,
+		},
+		{
+			name:       "insert existing rule frees its priority",
+			priorities: map[int64]string{1: "a", 2: "b", 3: "c", 4: "d"},
+			ruleARN:    "a",
+			lower:      4,
+			upper:      50000,
+			insert:     true,
+			expected:   4,
+			moves:      map[string]int64{"d": 5},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			priority, moves, err := tfelbv2.AllocateListenerRulePriority(tc.priorities, tc.ruleARN, tc.lower, tc.upper, tc.insert)
+
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if priority != tc.expected {
+				t.Errorf("expected priority %d, got %d", tc.expected, priority)
+			}
+
+			if len(moves) != len(tc.moves) {
+				t.Fatalf("expected moves %v, got %v", tc.moves, moves)
+			}
+
+			for arn, want := range tc.moves {
+				if got := moves[arn]; got != want {
+					t.Errorf("expected %s to move to %d, got %d", arn, want, got)
+				}
+			}
+		})
+	}
+}
+
 func TestAccELBV2ListenerRule_basic(t *testing.T) {
 	var conf elbv2.Rule
 	lbName := fmt.Sprintf("testrule-basic-%s", sdkacctest.RandString(13))
@@ -573,6 +693,44 @@ func TestAccELBV2ListenerRule_priority(t *testing.T) {
 	})
 }
 
+func TestAccELBV2ListenerRule_priorityAfter(t *testing.T) {
+	var rule elbv2.Rule
+	lbName := fmt.Sprintf("testrule-after-%s", sdkacctest.RandString(13))
+	targetGroupName := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elbv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckListenerRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleConfig_priorityAfter(lbName, targetGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists("aws_lb_listener_rule.after", &rule),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.first", "priority", "10"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.after", "priority", "11"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.band", "priority", "20"),
+				),
+			},
+			{
+				Config: testAccListenerRuleConfig_priorityAfterInserted(lbName, targetGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists("aws_lb_listener_rule.inserted", &rule),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.inserted", "priority", "11"),
+				),
+			},
+			{
+				Config: testAccListenerRuleConfig_priorityAfterInserted(lbName, targetGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.after", "priority", "12"),
+					resource.TestCheckResourceAttr("aws_lb_listener_rule.inserted", "priority", "11"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2ListenerRule_cognito(t *testing.T) {
 	var conf elbv2.Rule
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
@@ -2742,6 +2900,84 @@ resource "aws_lb_listener_rule" "last" {
 `)
 }
 
+func testAccListenerRuleConfig_priorityAfter(lbName, targetGroupName string) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_priorityBase(lbName, targetGroupName), `
+resource "aws_lb_listener_rule" "first" {
+  listener_arn = aws_lb_listener.front_end.arn
+  priority     = 10
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/first/*"]
+    }
+  }
+}
+
+resource "aws_lb_listener_rule" "after" {
+  listener_arn   = aws_lb_listener.front_end.arn
+  priority_after = aws_lb_listener_rule.first.arn
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/after/*"]
+    }
+  }
+}
+
+resource "aws_lb_listener_rule" "band" {
+  listener_arn = aws_lb_listener.front_end.arn
+
+  priority_band {
+    min = 20
+    max = 29
+  }
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/band/*"]
+    }
+  }
+}
+`)
+}
+
+func testAccListenerRuleConfig_priorityAfterInserted(lbName, targetGroupName string) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_priorityAfter(lbName, targetGroupName), `
+resource "aws_lb_listener_rule" "inserted" {
+  listener_arn   = aws_lb_listener.front_end.arn
+  priority_after = aws_lb_listener_rule.first.arn
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/inserted/*"]
+    }
+  }
+
+  depends_on = [aws_lb_listener_rule.after]
+}
+`)
+}
+
 func testAccListenerRuleConfig_priorityParallelism(lbName, targetGroupName string) string {
 	return acctest.ConfigCompose(testAccListenerRuleConfig_priorityStatic(lbName, targetGroupName), `
 resource "aws_lb_listener_rule" "parallelism" {
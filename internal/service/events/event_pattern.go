@@ -0,0 +1,818 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// Implementation of the EventBridge event pattern language.
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns-content-based-filtering.html
+//
+// The same language is used by Lambda event source mapping filter criteria and
+// SNS subscription filter policies.
+
+const (
+	eventPatternOr = "$or"
+
+	eventPatternOperatorAnythingBut      = "anything-but"
+	eventPatternOperatorCIDR             = "cidr"
+	eventPatternOperatorEqualsIgnoreCase = "equals-ignore-case"
+	eventPatternOperatorExists           = "exists"
+	eventPatternOperatorNumeric          = "numeric"
+	eventPatternOperatorPrefix           = "prefix"
+	eventPatternOperatorSuffix           = "suffix"
+	eventPatternOperatorWildcard         = "wildcard"
+)
+
+// EventPattern is a parsed event pattern.
+type EventPattern struct {
+	root *eventPatternNode
+}
+
+// eventPatternNode is a (nested) object in an event pattern.
+// All fields must match and, if present, at least one of the $or alternatives must match.
+type eventPatternNode struct {
+	fields map[string]*eventPatternNode
+	leaves map[string][]eventPatternMatcher
+	or     []*eventPatternNode
+}
+
+// eventPatternMatcher matches a single value in an event.
+// present is false if the field does not appear in the event.
+type eventPatternMatcher interface {
+	match(value interface{}, present bool) bool
+}
+
+// ParseEventPattern parses and validates the specified event pattern JSON.
+func ParseEventPattern(s string) (*EventPattern, error) {
+	v, err := decodeEventPatternJSON(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return nil, fmt.Errorf("event pattern must be a JSON object")
+	}
+
+	root, err := parseEventPatternNode(m, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventPattern{root: root}, nil
+}
+
+// Match reports whether the specified event JSON matches the event pattern.
+func (p *EventPattern) Match(event string) (bool, error) {
+	v, err := decodeEventPatternJSON(event)
+
+	if err != nil {
+		return false, fmt.Errorf("decoding event: %w", err)
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return false, fmt.Errorf("event must be a JSON object")
+	}
+
+	return p.root.match(m), nil
+}
+
+// ValidEventPattern is a SchemaValidateFunc that validates an event pattern.
+// An empty string is not validated.
+func ValidEventPattern(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	return validateEventPattern(value, k)
+}
+
+// validateEventPattern parses an event pattern for validation.
+// Operators that aren't implemented locally may still be accepted by AWS, so they are reported as warnings.
+func validateEventPattern(s, k string) (ws []string, errs []error) {
+	if _, err := ParseEventPattern(s); err != nil {
+		var unsupported *eventPatternUnsupportedOperatorError
+
+		if errors.As(err, &unsupported) {
+			ws = append(ws, fmt.Sprintf("%q could not be fully validated: %s", k, err))
+		} else {
+			errs = append(errs, fmt.Errorf("%q is not a valid event pattern: %w", k, err))
+		}
+	}
+
+	return
+}
+
+// eventPatternUnsupportedOperatorError is returned when a content filter uses an operator
+// that isn't implemented locally.
+type eventPatternUnsupportedOperatorError struct {
+	path     string
+	operator string
+}
+
+func (e *eventPatternUnsupportedOperatorError) Error() string {
+	return fmt.Sprintf("%s: unsupported operator %q", e.path, e.operator)
+}
+
+func decodeEventPatternJSON(s string) (interface{}, error) {
+	var v interface{}
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after top-level JSON value")
+	}
+
+	return v, nil
+}
+
+func parseEventPatternNode(m map[string]interface{}, path string) (*eventPatternNode, error) {
+	if len(m) == 0 {
+		return nil, fmt.Errorf("%s: pattern must not be empty", eventPatternPath(path, ""))
+	}
+
+	node := &eventPatternNode{
+		fields: make(map[string]*eventPatternNode),
+		leaves: make(map[string][]eventPatternMatcher),
+	}
+
+	// Iterate in a stable order so that the first error reported is deterministic.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fieldPath := eventPatternPath(path, k)
+
+		switch v := m[k].(type) {
+		case map[string]interface{}:
+			child, err := parseEventPatternNode(v, fieldPath)
+
+			if err != nil {
+				return nil, err
+			}
+
+			node.fields[k] = child
+
+		case []interface{}:
+			if k == eventPatternOr {
+				if len(v) < 2 {
+					return nil, fmt.Errorf("%s: must contain at least 2 patterns", fieldPath)
+				}
+
+				for i, v := range v {
+					m, ok := v.(map[string]interface{})
+
+					if !ok {
+						return nil, fmt.Errorf("%s[%d]: must be an object", fieldPath, i)
+					}
+
+					child, err := parseEventPatternNode(m, path)
+
+					if err != nil {
+						return nil, err
+					}
+
+					node.or = append(node.or, child)
+				}
+
+				continue
+			}
+
+			if len(v) == 0 {
+				return nil, fmt.Errorf("%s: must contain at least one value", fieldPath)
+			}
+
+			for _, v := range v {
+				matcher, err := parseEventPatternMatcher(v, fieldPath)
+
+				if err != nil {
+					return nil, err
+				}
+
+				node.leaves[k] = append(node.leaves[k], matcher)
+			}
+
+		default:
+			return nil, fmt.Errorf("%s: must be an object or an array, got %s", fieldPath, eventPatternJSON(v))
+		}
+	}
+
+	return node, nil
+}
+
+func parseEventPatternMatcher(v interface{}, path string) (eventPatternMatcher, error) {
+	switch v := v.(type) {
+	case nil, bool, string, json.Number:
+		return eventPatternExact{value: v}, nil
+
+	case map[string]interface{}:
+		if len(v) != 1 {
+			return nil, fmt.Errorf("%s: content filter %s must contain exactly one operator", path, eventPatternJSON(v))
+		}
+
+		for operator, operand := range v {
+			switch operator {
+			case eventPatternOperatorAnythingBut:
+				return parseEventPatternAnythingBut(operand, path)
+
+			case eventPatternOperatorCIDR:
+				s, ok := operand.(string)
+
+				if !ok {
+					return nil, fmt.Errorf("%s: %q operand must be a string", path, operator)
+				}
+
+				_, ipNet, err := net.ParseCIDR(s)
+
+				if err != nil {
+					return nil, fmt.Errorf("%s: %q operand %q is not a valid CIDR block", path, operator, s)
+				}
+
+				return eventPatternCIDR{ipNet: ipNet}, nil
+
+			case eventPatternOperatorExists:
+				b, ok := operand.(bool)
+
+				if !ok {
+					return nil, fmt.Errorf("%s: %q operand must be a boolean", path, operator)
+				}
+
+				return eventPatternExists{exists: b}, nil
+
+			case eventPatternOperatorNumeric:
+				return parseEventPatternNumeric(operand, path)
+
+			case eventPatternOperatorPrefix, eventPatternOperatorSuffix:
+				// The operand may itself be an "equals-ignore-case" filter.
+				if m, ok := operand.(map[string]interface{}); ok && len(m) == 1 {
+					if s, ok := m[eventPatternOperatorEqualsIgnoreCase].(string); ok {
+						return newEventPatternStringMatcher(operator, s, true, path)
+					}
+				}
+
+				s, ok := operand.(string)
+
+				if !ok {
+					return nil, fmt.Errorf("%s: %q operand must be a string", path, operator)
+				}
+
+				return newEventPatternStringMatcher(operator, s, false, path)
+
+			case eventPatternOperatorEqualsIgnoreCase, eventPatternOperatorWildcard:
+				s, ok := operand.(string)
+
+				if !ok {
+					return nil, fmt.Errorf("%s: %q operand must be a string", path, operator)
+				}
+
+				return newEventPatternStringMatcher(operator, s, false, path)
+
+			default:
+				return nil, &eventPatternUnsupportedOperatorError{path: path, operator: operator}
+			}
+		}
+
+	case []interface{}:
+		return nil, fmt.Errorf("%s: nested arrays are not supported", path)
+	}
+
+	return nil, fmt.Errorf("%s: unsupported value %s", path, eventPatternJSON(v))
+}
+
+// newEventPatternStringMatcher returns the matcher for a string operator.
+func newEventPatternStringMatcher(operator, s string, ignoreCase bool, path string) (eventPatternMatcher, error) {
+	switch operator {
+	case eventPatternOperatorEqualsIgnoreCase:
+		return eventPatternEqualsIgnoreCase{value: s}, nil
+	case eventPatternOperatorPrefix:
+		return eventPatternPrefix{value: s, ignoreCase: ignoreCase}, nil
+	case eventPatternOperatorSuffix:
+		return eventPatternSuffix{value: s, ignoreCase: ignoreCase}, nil
+	case eventPatternOperatorWildcard:
+		return parseEventPatternWildcard(s, path)
+	}
+
+	return nil, &eventPatternUnsupportedOperatorError{path: path, operator: operator}
+}
+
+func parseEventPatternAnythingBut(operand interface{}, path string) (eventPatternMatcher, error) {
+	switch v := operand.(type) {
+	case string, json.Number:
+		return eventPatternAnythingBut{values: []eventPatternMatcher{eventPatternExact{value: v}}}, nil
+
+	case []interface{}:
+		if len(v) == 0 {
+			return nil, fmt.Errorf("%s: %q list must contain at least one value", path, eventPatternOperatorAnythingBut)
+		}
+
+		var values []eventPatternMatcher
+		var kind string
+
+		for _, v := range v {
+			var k string
+
+			switch v.(type) {
+			case string:
+				k = "string"
+			case json.Number:
+				k = "number"
+			default:
+				return nil, fmt.Errorf("%s: %q list values must be strings or numbers", path, eventPatternOperatorAnythingBut)
+			}
+
+			if kind != "" && k != kind {
+				return nil, fmt.Errorf("%s: %q list values must all be strings or all be numbers", path, eventPatternOperatorAnythingBut)
+			}
+
+			kind = k
+			values = append(values, eventPatternExact{value: v})
+		}
+
+		return eventPatternAnythingBut{values: values}, nil
+
+	case map[string]interface{}:
+		if len(v) != 1 {
+			return nil, fmt.Errorf("%s: %q filter must contain exactly one operator", path, eventPatternOperatorAnythingBut)
+		}
+
+		for operator, operand := range v {
+			switch operator {
+			case eventPatternOperatorEqualsIgnoreCase, eventPatternOperatorPrefix, eventPatternOperatorSuffix, eventPatternOperatorWildcard:
+			default:
+				return nil, &eventPatternUnsupportedOperatorError{path: path, operator: eventPatternOperatorAnythingBut + " " + operator}
+			}
+
+			// The operand is either a single string or a list of strings.
+			var operands []interface{}
+
+			switch operand := operand.(type) {
+			case string:
+				operands = []interface{}{operand}
+			case []interface{}:
+				if len(operand) == 0 {
+					return nil, fmt.Errorf("%s: %q %q list must contain at least one value", path, eventPatternOperatorAnythingBut, operator)
+				}
+
+				operands = operand
+			}
+
+			if len(operands) == 0 {
+				return nil, fmt.Errorf("%s: %q %q operand must be a string or a list of strings", path, eventPatternOperatorAnythingBut, operator)
+			}
+
+			var values []eventPatternMatcher
+
+			for _, operand := range operands {
+				s, ok := operand.(string)
+
+				if !ok {
+					return nil, fmt.Errorf("%s: %q %q operand must be a string or a list of strings", path, eventPatternOperatorAnythingBut, operator)
+				}
+
+				matcher, err := newEventPatternStringMatcher(operator, s, false, path)
+
+				if err != nil {
+					return nil, err
+				}
+
+				values = append(values, matcher)
+			}
+
+			return eventPatternAnythingBut{values: values}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: unsupported %q operand %s", path, eventPatternOperatorAnythingBut, eventPatternJSON(operand))
+}
+
+// parseEventPatternWildcard parses a "wildcard" operand, in which "*" matches any sequence of
+// characters and "\" escapes "*" and "\".
+func parseEventPatternWildcard(s, path string) (eventPatternMatcher, error) {
+	var segments []string
+	var segment strings.Builder
+	escaped, star := false, false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			if r != '*' && r != '\\' {
+				return nil, fmt.Errorf("%s: %q operand %q contains an invalid escape sequence", path, eventPatternOperatorWildcard, s)
+			}
+
+			segment.WriteRune(r)
+			escaped, star = false, false
+		case r == '\\':
+			escaped = true
+		case r == '*':
+			if star {
+				return nil, fmt.Errorf("%s: %q operand %q must not contain consecutive wildcard characters", path, eventPatternOperatorWildcard, s)
+			}
+
+			segments = append(segments, segment.String())
+			segment.Reset()
+			star = true
+		default:
+			segment.WriteRune(r)
+			star = false
+		}
+	}
+
+	if escaped {
+		return nil, fmt.Errorf("%s: %q operand %q contains an invalid escape sequence", path, eventPatternOperatorWildcard, s)
+	}
+
+	return eventPatternWildcard{segments: append(segments, segment.String())}, nil
+}
+
+func parseEventPatternNumeric(operand interface{}, path string) (eventPatternMatcher, error) {
+	v, ok := operand.([]interface{})
+
+	if !ok || (len(v) != 2 && len(v) != 4) {
+		return nil, fmt.Errorf("%s: %q operand must be a list of 1 or 2 comparisons", path, eventPatternOperatorNumeric)
+	}
+
+	matcher := eventPatternNumeric{}
+	var hasLower, hasUpper bool
+
+	for i := 0; i < len(v); i += 2 {
+		operator, ok := v[i].(string)
+
+		if !ok {
+			return nil, fmt.Errorf("%s: %q comparison operator must be a string", path, eventPatternOperatorNumeric)
+		}
+
+		n, ok := v[i+1].(json.Number)
+
+		if !ok {
+			return nil, fmt.Errorf("%s: %q comparison value must be a number", path, eventPatternOperatorNumeric)
+		}
+
+		f, err := n.Float64()
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %q comparison value %s: %w", path, eventPatternOperatorNumeric, n, err)
+		}
+
+		cmp := eventPatternComparison{operator: operator, value: f}
+
+		switch operator {
+		case "=":
+			if len(v) != 2 {
+				return nil, fmt.Errorf("%s: %q operator \"=\" cannot be combined with other comparisons", path, eventPatternOperatorNumeric)
+			}
+		case ">", ">=":
+			if hasLower {
+				return nil, fmt.Errorf("%s: %q must contain at most one lower bound", path, eventPatternOperatorNumeric)
+			}
+			hasLower = true
+		case "<", "<=":
+			if hasUpper {
+				return nil, fmt.Errorf("%s: %q must contain at most one upper bound", path, eventPatternOperatorNumeric)
+			}
+			hasUpper = true
+		default:
+			return nil, fmt.Errorf("%s: unsupported %q comparison operator %q", path, eventPatternOperatorNumeric, operator)
+		}
+
+		matcher.comparisons = append(matcher.comparisons, cmp)
+	}
+
+	return matcher, nil
+}
+
+func (n *eventPatternNode) match(event map[string]interface{}) bool {
+	for k, matchers := range n.leaves {
+		value, present := event[k]
+
+		if !eventPatternMatchAny(matchers, value, present) {
+			return false
+		}
+	}
+
+	for k, child := range n.fields {
+		if !child.matchValue(event[k]) {
+			return false
+		}
+	}
+
+	if len(n.or) == 0 {
+		return true
+	}
+
+	for _, child := range n.or {
+		if child.match(event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchValue matches a nested pattern against an event field's value.
+// A missing or non-object value is treated as an empty object so that "exists": false works for nested fields.
+func (n *eventPatternNode) matchValue(value interface{}) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return n.match(v)
+	case []interface{}:
+		for _, v := range v {
+			if m, ok := v.(map[string]interface{}); ok && n.match(m) {
+				return true
+			}
+		}
+		return false
+	default:
+		return n.match(map[string]interface{}{})
+	}
+}
+
+// eventPatternMatchAny reports whether any matcher matches the value.
+// Array values match if any element matches.
+func eventPatternMatchAny(matchers []eventPatternMatcher, value interface{}, present bool) bool {
+	for _, matcher := range matchers {
+		if values, ok := value.([]interface{}); ok {
+			if _, ok := matcher.(eventPatternExists); ok {
+				if matcher.match(value, present) {
+					return true
+				}
+				continue
+			}
+
+			for _, v := range values {
+				if matcher.match(v, true) {
+					return true
+				}
+			}
+
+			continue
+		}
+
+		if matcher.match(value, present) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type eventPatternExact struct {
+	value interface{}
+}
+
+func (m eventPatternExact) match(value interface{}, present bool) bool {
+	if !present {
+		return false
+	}
+
+	switch want := m.value.(type) {
+	case json.Number:
+		got, ok := value.(json.Number)
+
+		if !ok {
+			return false
+		}
+
+		return eventPatternNumbersEqual(want, got)
+	default:
+		return want == value
+	}
+}
+
+type eventPatternEqualsIgnoreCase struct {
+	value string
+}
+
+func (m eventPatternEqualsIgnoreCase) match(value interface{}, present bool) bool {
+	s, ok := value.(string)
+
+	return present && ok && strings.EqualFold(s, m.value)
+}
+
+type eventPatternPrefix struct {
+	value      string
+	ignoreCase bool
+}
+
+func (m eventPatternPrefix) match(value interface{}, present bool) bool {
+	s, ok := value.(string)
+
+	if m.ignoreCase {
+		return present && ok && len(s) >= len(m.value) && strings.EqualFold(s[:len(m.value)], m.value)
+	}
+
+	return present && ok && strings.HasPrefix(s, m.value)
+}
+
+type eventPatternSuffix struct {
+	value      string
+	ignoreCase bool
+}
+
+func (m eventPatternSuffix) match(value interface{}, present bool) bool {
+	s, ok := value.(string)
+
+	if m.ignoreCase {
+		return present && ok && len(s) >= len(m.value) && strings.EqualFold(s[len(s)-len(m.value):], m.value)
+	}
+
+	return present && ok && strings.HasSuffix(s, m.value)
+}
+
+// eventPatternWildcard matches strings made up of the literal segments, in order,
+// separated by any sequences of characters.
+type eventPatternWildcard struct {
+	segments []string
+}
+
+func (m eventPatternWildcard) match(value interface{}, present bool) bool {
+	s, ok := value.(string)
+
+	if !present || !ok {
+		return false
+	}
+
+	first, last := m.segments[0], m.segments[len(m.segments)-1]
+
+	if len(m.segments) == 1 {
+		return s == first
+	}
+
+	if !strings.HasPrefix(s, first) {
+		return false
+	}
+
+	s = s[len(first):]
+
+	for _, segment := range m.segments[1 : len(m.segments)-1] {
+		i := strings.Index(s, segment)
+
+		if i < 0 {
+			return false
+		}
+
+		s = s[i+len(segment):]
+	}
+
+	return strings.HasSuffix(s, last)
+}
+
+type eventPatternAnythingBut struct {
+	values []eventPatternMatcher
+}
+
+func (m eventPatternAnythingBut) match(value interface{}, present bool) bool {
+	if !present {
+		return false
+	}
+
+	for _, v := range m.values {
+		if v.match(value, present) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type eventPatternExists struct {
+	exists bool
+}
+
+func (m eventPatternExists) match(value interface{}, present bool) bool {
+	return present == m.exists
+}
+
+type eventPatternCIDR struct {
+	ipNet *net.IPNet
+}
+
+func (m eventPatternCIDR) match(value interface{}, present bool) bool {
+	s, ok := value.(string)
+
+	if !present || !ok {
+		return false
+	}
+
+	ip := net.ParseIP(s)
+
+	return ip != nil && m.ipNet.Contains(ip)
+}
+
+type eventPatternComparison struct {
+	operator string
+	value    float64
+}
+
+type eventPatternNumeric struct {
+	comparisons []eventPatternComparison
+}
+
+func (m eventPatternNumeric) match(value interface{}, present bool) bool {
+	n, ok := value.(json.Number)
+
+	if !present || !ok {
+		return false
+	}
+
+	f, err := n.Float64()
+
+	if err != nil {
+		return false
+	}
+
+	for _, cmp := range m.comparisons {
+		var ok bool
+
+		switch cmp.operator {
+		case "=":
+			ok = f == cmp.value
+		case ">":
+			ok = f > cmp.value
+		case ">=":
+			ok = f >= cmp.value
+		case "<":
+			ok = f < cmp.value
+		case "<=":
+			ok = f <= cmp.value
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func eventPatternNumbersEqual(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+
+	x, err := a.Float64()
+
+	if err != nil {
+		return false
+	}
+
+	y, err := b.Float64()
+
+	if err != nil {
+		return false
+	}
+
+	return x == y
+}
+
+func eventPatternPath(path, key string) string {
+	switch {
+	case path == "" && key == "":
+		return "pattern"
+	case path == "":
+		return key
+	case key == "":
+		return path
+	default:
+		return path + "." + key
+	}
+}
+
+func eventPatternJSON(v interface{}) string {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return strings.TrimSpace(buf.String())
+}
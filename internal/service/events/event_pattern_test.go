@@ -0,0 +1,180 @@
+package events
+
+import (
+	"testing"
+)
+
+func TestParseEventPattern(t *testing.T) {
+	cases := []struct {
+		Pattern string
+		IsValid bool
+	}{
+		{`{"source": ["aws.ec2"]}`, true},
+		{`{"detail": {"state": ["running", "stopped"]}}`, true},
+		{`{"detail": {"count": [5, null, true]}}`, true},
+		{`{"source": [{"prefix": "aws."}]}`, true},
+		{`{"source": [{"suffix": ".png"}]}`, true},
+		{`{"source": [{"equals-ignore-case": "AWS.EC2"}]}`, true},
+		{`{"source": [{"anything-but": "aws.ec2"}]}`, true},
+		{`{"source": [{"anything-but": ["aws.ec2", "aws.s3"]}]}`, true},
+		{`{"source": [{"anything-but": [1, 2]}]}`, true},
+		{`{"source": [{"anything-but": {"prefix": "aws."}}]}`, true},
+		{`{"detail": {"count": [{"numeric": [">", 0, "<=", 5]}]}}`, true},
+		{`{"detail": {"count": [{"numeric": ["=", 3.5]}]}}`, true},
+		{`{"detail": {"c-count": [{"exists": false}]}}`, true},
+		{`{"detail": {"ip": [{"cidr": "10.0.0.0/24"}]}}`, true},
+		{`{"source": ["aws.ec2"], "$or": [{"detail-type": ["a"]}, {"detail": {"x": [1]}}]}`, true},
+		{`{"source": [{"wildcard": "aws.*"}]}`, true},
+		{`{"source": [{"wildcard": "a\\*b"}]}`, true},
+		{`{"source": [{"prefix": {"equals-ignore-case": "AWS."}}]}`, true},
+		{`{"source": [{"suffix": {"equals-ignore-case": ".PNG"}}]}`, true},
+		{`{"source": [{"anything-but": {"prefix": ["aws.", "custom."]}}]}`, true},
+		{`{"source": [{"anything-but": {"suffix": ".png"}}]}`, true},
+		{`{"source": [{"anything-but": {"equals-ignore-case": ["AWS.EC2", "AWS.S3"]}}]}`, true},
+		{`{"source": [{"anything-but": {"wildcard": "aws.*"}}]}`, true},
+		{``, false},
+		{`{`, false},
+		{`[]`, false},
+		{`{}`, false},
+		{`{"source": "aws.ec2"}`, false},
+		{`{"source": []}`, false},
+		{`{"source": [["aws.ec2"]]}`, false},
+		{`{"detail": {}}`, false},
+		{`{"source": [{"prefix": 1}]}`, false},
+		{`{"source": [{"prefix": "a", "suffix": "b"}]}`, false},
+		{`{"source": [{"wildcard-ish": "a"}]}`, false},
+		{`{"source": [{"anything-but": []}]}`, false},
+		{`{"source": [{"anything-but": ["a", 1]}]}`, false},
+		{`{"source": [{"anything-but": {"exists": true}}]}`, false},
+		{`{"source": [{"anything-but": {"prefix": []}}]}`, false},
+		{`{"source": [{"anything-but": {"prefix": [1]}}]}`, false},
+		{`{"source": [{"wildcard": "aws.**"}]}`, false},
+		{`{"source": [{"wildcard": "aws.\\x"}]}`, false},
+		{`{"source": [{"wildcard": 1}]}`, false},
+		{`{"detail": {"count": [{"numeric": [">", "0"]}]}}`, false},
+		{`{"detail": {"count": [{"numeric": [">", 0, ">", 5]}]}}`, false},
+		{`{"detail": {"count": [{"numeric": ["=", 0, "<", 5]}]}}`, false},
+		{`{"detail": {"count": [{"numeric": ["!=", 0]}]}}`, false},
+		{`{"detail": {"count": [{"numeric": [">"]}]}}`, false},
+		{`{"detail": {"x": [{"exists": "true"}]}}`, false},
+		{`{"detail": {"ip": [{"cidr": "10.0.0.0"}]}}`, false},
+		{`{"$or": [{"source": ["a"]}]}`, false},
+		{`{"$or": [{"source": ["a"]}, "b"]}`, false},
+	}
+
+	for _, tc := range cases {
+		_, err := ParseEventPattern(tc.Pattern)
+
+		if got := err == nil; got != tc.IsValid {
+			t.Errorf("ParseEventPattern(%s): expected valid %t, got error: %v", tc.Pattern, tc.IsValid, err)
+		}
+	}
+}
+
+func TestEventPatternMatch(t *testing.T) {
+	event := `{
+  "source": "aws.ec2",
+  "detail-type": "EC2 Instance State-change Notification",
+  "resources": ["arn:aws:ec2:us-east-1:123456789012:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "running",
+    "count": 5,
+    "source-ip": "10.0.0.12",
+    "tags": ["blue", "green"],
+    "flag": null,
+    "items": [{"name": "a"}, {"name": "b"}]
+  }
+}`
+
+	cases := []struct {
+		Name    string
+		Pattern string
+		Matches bool
+	}{
+		{"exact", `{"source": ["aws.ec2"]}`, true},
+		{"exact no match", `{"source": ["aws.s3"]}`, false},
+		{"missing field", `{"account": ["123456789012"]}`, false},
+		{"nested exact", `{"detail": {"state": ["pending", "running"]}}`, true},
+		{"number", `{"detail": {"count": [5.0]}}`, true},
+		{"null", `{"detail": {"flag": [null]}}`, true},
+		{"array value", `{"detail": {"tags": ["green"]}}`, true},
+		{"array value no match", `{"detail": {"tags": ["red"]}}`, false},
+		{"array of objects", `{"detail": {"items": {"name": ["b"]}}}`, true},
+		{"prefix", `{"resources": [{"prefix": "arn:aws:ec2:"}]}`, true},
+		{"suffix", `{"detail": {"instance-id": [{"suffix": "def0"}]}}`, true},
+		{"suffix no match", `{"detail": {"instance-id": [{"suffix": "def1"}]}}`, false},
+		{"equals-ignore-case", `{"source": [{"equals-ignore-case": "AWS.EC2"}]}`, true},
+		{"anything-but", `{"detail": {"state": [{"anything-but": ["stopped", "terminated"]}]}}`, true},
+		{"anything-but no match", `{"detail": {"state": [{"anything-but": "running"}]}}`, false},
+		{"anything-but missing", `{"detail": {"other": [{"anything-but": "running"}]}}`, false},
+		{"anything-but prefix", `{"source": [{"anything-but": {"prefix": "aws."}}]}`, false},
+		{"anything-but prefix list", `{"source": [{"anything-but": {"prefix": ["aws.s3", "custom."]}}]}`, true},
+		{"anything-but equals-ignore-case", `{"source": [{"anything-but": {"equals-ignore-case": ["AWS.EC2"]}}]}`, false},
+		{"anything-but wildcard", `{"source": [{"anything-but": {"wildcard": "*.s3"}}]}`, true},
+		{"prefix equals-ignore-case", `{"source": [{"prefix": {"equals-ignore-case": "AWS."}}]}`, true},
+		{"suffix equals-ignore-case", `{"source": [{"suffix": {"equals-ignore-case": ".EC2"}}]}`, true},
+		{"wildcard", `{"detail-type": [{"wildcard": "EC2 * State-change *"}]}`, true},
+		{"wildcard no match", `{"detail-type": [{"wildcard": "EC2 * Notification *"}]}`, false},
+		{"wildcard exact", `{"source": [{"wildcard": "aws.ec2"}]}`, true},
+		{"wildcard overlapping segments", `{"source": [{"wildcard": "aws.*.ec2"}]}`, false},
+		{"wildcard array value", `{"resources": [{"wildcard": "arn:aws:ec2:*:instance/*"}]}`, true},
+		{"numeric range", `{"detail": {"count": [{"numeric": [">", 0, "<=", 5]}]}}`, true},
+		{"numeric range no match", `{"detail": {"count": [{"numeric": [">", 5]}]}}`, false},
+		{"numeric string", `{"detail": {"state": [{"numeric": [">", 0]}]}}`, false},
+		{"exists", `{"detail": {"state": [{"exists": true}]}}`, true},
+		{"exists no match", `{"detail": {"other": [{"exists": true}]}}`, false},
+		{"not exists", `{"detail": {"other": [{"exists": false}]}}`, true},
+		{"not exists nested object", `{"other": {"x": [{"exists": false}]}}`, true},
+		{"not exists no match", `{"detail": {"state": [{"exists": false}]}}`, false},
+		{"cidr", `{"detail": {"source-ip": [{"cidr": "10.0.0.0/24"}]}}`, true},
+		{"cidr no match", `{"detail": {"source-ip": [{"cidr": "10.0.1.0/24"}]}}`, false},
+		{"multiple fields", `{"source": ["aws.ec2"], "detail": {"state": ["stopped"]}}`, false},
+		{"or", `{"$or": [{"source": ["aws.s3"]}, {"detail": {"count": [5]}}]}`, true},
+		{"or no match", `{"$or": [{"source": ["aws.s3"]}, {"detail": {"count": [6]}}]}`, false},
+		{"or with field", `{"source": ["aws.s3"], "$or": [{"detail-type": ["x"]}, {"detail": {"count": [5]}}]}`, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			pattern, err := ParseEventPattern(tc.Pattern)
+
+			if err != nil {
+				t.Fatalf("error parsing pattern: %s", err)
+			}
+
+			got, err := pattern.Match(event)
+
+			if err != nil {
+				t.Fatalf("error matching event: %s", err)
+			}
+
+			if got != tc.Matches {
+				t.Errorf("expected %t, got %t", tc.Matches, got)
+			}
+		})
+	}
+}
+
+func TestValidEventPattern(t *testing.T) {
+	cases := []struct {
+		Pattern  string
+		Warnings int
+		Errors   int
+	}{
+		{``, 0, 0},
+		{`{"source": [{"wildcard": "aws.*"}]}`, 0, 0},
+		{`{"source": [{"future-operator": "aws."}]}`, 1, 0},
+		{`{"source": [{"anything-but": {"future-operator": "aws."}}]}`, 1, 0},
+		{`{"source": [{"prefix": 1}]}`, 0, 1},
+		{`{`, 0, 1},
+	}
+
+	for _, tc := range cases {
+		ws, errs := ValidEventPattern(tc.Pattern, "event_pattern")
+
+		if len(ws) != tc.Warnings || len(errs) != tc.Errors {
+			t.Errorf("ValidEventPattern(%s): expected %d warnings and %d errors, got %q and %q", tc.Pattern, tc.Warnings, tc.Errors, ws, errs)
+		}
+	}
+}
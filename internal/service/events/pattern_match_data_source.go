@@ -0,0 +1,90 @@
+package events
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourcePatternMatch() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePatternMatchRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_match": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"any_match": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEventPatternValue(),
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"matches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeBool,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePatternMatchRead(d *schema.ResourceData, meta interface{}) error {
+	eventPattern, err := structure.NormalizeJsonString(d.Get("event_pattern").(string))
+
+	if err != nil {
+		return fmt.Errorf("event pattern contains an invalid JSON: %w", err)
+	}
+
+	pattern, err := ParseEventPattern(eventPattern)
+
+	if err != nil {
+		return fmt.Errorf("error parsing event pattern: %w", err)
+	}
+
+	var events []string
+	for _, v := range d.Get("events").([]interface{}) {
+		events = append(events, v.(string))
+	}
+
+	matches := make([]bool, len(events))
+	allMatch, anyMatch := true, false
+
+	for i, event := range events {
+		match, err := pattern.Match(event)
+
+		if err != nil {
+			return fmt.Errorf("error matching event %d: %w", i, err)
+		}
+
+		matches[i] = match
+		allMatch = allMatch && match
+		anyMatch = anyMatch || match
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(eventPattern + strings.Join(events, ""))))
+	d.Set("all_match", allMatch)
+	d.Set("any_match", anyMatch)
+	d.Set("matches", matches)
+
+	return nil
+}
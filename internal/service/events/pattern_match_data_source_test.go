@@ -0,0 +1,79 @@
+package events_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEventsPatternMatchDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_cloudwatch_event_pattern_match.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternMatchDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "matches.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.0", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.1", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.2", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "all_match", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "any_match", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternMatchDataSource_invalidPattern(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternMatchDataSourceConfigInvalidPattern,
+				ExpectError: regexp.MustCompile(`unsupported "numeric" comparison operator "!="`),
+			},
+		},
+	})
+}
+
+const testAccPatternMatchDataSourceConfig = `
+data "aws_cloudwatch_event_pattern_match" "test" {
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+    detail = {
+      state = [{ "anything-but" = "terminated" }]
+      count = [{ numeric = [">", 0, "<=", 5] }]
+    }
+  })
+
+  events = [
+    jsonencode({ source = "aws.ec2", detail = { state = "running", count = 3 } }),
+    jsonencode({ source = "aws.ec2", detail = { state = "terminated", count = 3 } }),
+    jsonencode({ source = "aws.s3", detail = { state = "running", count = 3 } }),
+  ]
+}
+`
+
+const testAccPatternMatchDataSourceConfigInvalidPattern = `
+data "aws_cloudwatch_event_pattern_match" "test" {
+  event_pattern = jsonencode({
+    detail = {
+      count = [{ numeric = ["!=", 0] }]
+    }
+  })
+
+  events = [
+    jsonencode({ detail = { count = 3 } }),
+  ]
+}
+`
@@ -409,6 +409,10 @@ func validateEventPatternValue() schema.SchemaValidateFunc {
 		if len(json) > maxJsonLength {
 			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters: %q", k, maxJsonLength, json))
 		}
-		return
+
+		ws, errs := validateEventPattern(json, k)
+		errors = append(errors, errs...)
+
+		return ws, errors
 	}
 }
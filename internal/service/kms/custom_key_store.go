@@ -0,0 +1,421 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCustomKeyStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomKeyStoreCreate,
+		Read:   resourceCustomKeyStoreRead,
+		Update: resourceCustomKeyStoreUpdate,
+		Delete: resourceCustomKeyStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(CustomKeyStoreConnectedTimeout),
+			Update: schema.DefaultTimeout(CustomKeyStoreConnectedTimeout),
+			Delete: schema.DefaultTimeout(CustomKeyStoreDisconnectedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloud_hsm_cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(19, 24),
+			},
+			"connected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"connection_error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_key_store_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"custom_key_store_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      kms.CustomKeyStoreTypeAwsCloudhsm,
+				ValidateFunc: validation.StringInSlice(kms.CustomKeyStoreType_Values(), false),
+			},
+			"key_store_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(7, 32),
+			},
+			"trust_anchor_certificate": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"xks_proxy_authentication_credential": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(20, 30),
+						},
+						"raw_secret_access_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(43, 64),
+						},
+					},
+				},
+			},
+			"xks_proxy_connectivity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(kms.XksProxyConnectivityType_Values(), false),
+			},
+			"xks_proxy_uri_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(10, 128),
+			},
+			"xks_proxy_uri_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(10, 128),
+			},
+			"xks_proxy_vpc_endpoint_service_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(20, 64),
+			},
+		},
+	}
+}
+
+func resourceCustomKeyStoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	name := d.Get("custom_key_store_name").(string)
+	input := &kms.CreateCustomKeyStoreInput{
+		CustomKeyStoreName: aws.String(name),
+		CustomKeyStoreType: aws.String(d.Get("custom_key_store_type").(string)),
+	}
+
+	if v, ok := d.GetOk("cloud_hsm_cluster_id"); ok {
+		input.CloudHsmClusterId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key_store_password"); ok {
+		input.KeyStorePassword = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("trust_anchor_certificate"); ok {
+		input.TrustAnchorCertificate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_authentication_credential"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_connectivity"); ok {
+		input.XksProxyConnectivity = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_uri_endpoint"); ok {
+		input.XksProxyUriEndpoint = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_uri_path"); ok {
+		input.XksProxyUriPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_vpc_endpoint_service_name"); ok {
+		input.XksProxyVpcEndpointServiceName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating KMS Custom Key Store: %s", input)
+	output, err := conn.CreateCustomKeyStore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating KMS Custom Key Store (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CustomKeyStoreId))
+
+	if d.Get("connected").(bool) {
+		if err := connectCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomKeyStoreRead(d, meta)
+}
+
+func resourceCustomKeyStoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	output, err := FindCustomKeyStoreByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Custom Key Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): %w", d.Id(), err)
+	}
+
+	d.Set("cloud_hsm_cluster_id", output.CloudHsmClusterId)
+	d.Set("connected", aws.StringValue(output.ConnectionState) == kms.ConnectionStateTypeConnected)
+	d.Set("connection_error_code", output.ConnectionErrorCode)
+	d.Set("connection_state", output.ConnectionState)
+	if output.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("custom_key_store_name", output.CustomKeyStoreName)
+	d.Set("custom_key_store_type", output.CustomKeyStoreType)
+	d.Set("trust_anchor_certificate", output.TrustAnchorCertificate)
+
+	if v := output.XksProxyConfiguration; v != nil {
+		d.Set("xks_proxy_connectivity", v.Connectivity)
+		d.Set("xks_proxy_uri_endpoint", v.UriEndpoint)
+		d.Set("xks_proxy_uri_path", v.UriPath)
+		d.Set("xks_proxy_vpc_endpoint_service_name", v.VpcEndpointServiceName)
+	} else {
+		d.Set("xks_proxy_connectivity", nil)
+		d.Set("xks_proxy_uri_endpoint", nil)
+		d.Set("xks_proxy_uri_path", nil)
+		d.Set("xks_proxy_vpc_endpoint_service_name", nil)
+	}
+
+	return nil
+}
+
+func resourceCustomKeyStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+	reconnect := false
+
+	if d.HasChangesExcept("connected") {
+		input := &kms.UpdateCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("cloud_hsm_cluster_id") {
+			input.CloudHsmClusterId = aws.String(d.Get("cloud_hsm_cluster_id").(string))
+		}
+
+		if d.HasChange("custom_key_store_name") {
+			input.NewCustomKeyStoreName = aws.String(d.Get("custom_key_store_name").(string))
+		}
+
+		if d.HasChange("key_store_password") {
+			input.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
+		}
+
+		if d.HasChange("xks_proxy_authentication_credential") {
+			if v, ok := d.GetOk("xks_proxy_authentication_credential"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("xks_proxy_connectivity") {
+			input.XksProxyConnectivity = aws.String(d.Get("xks_proxy_connectivity").(string))
+		}
+
+		if d.HasChange("xks_proxy_uri_endpoint") {
+			input.XksProxyUriEndpoint = aws.String(d.Get("xks_proxy_uri_endpoint").(string))
+		}
+
+		if d.HasChange("xks_proxy_uri_path") {
+			input.XksProxyUriPath = aws.String(d.Get("xks_proxy_uri_path").(string))
+		}
+
+		if d.HasChange("xks_proxy_vpc_endpoint_service_name") {
+			input.XksProxyVpcEndpointServiceName = aws.String(d.Get("xks_proxy_vpc_endpoint_service_name").(string))
+		}
+
+		// All changes to a CloudHSM key store, and changes to an external key store's
+		// proxy connectivity, require the key store to be disconnected.
+		if d.Get("custom_key_store_type").(string) == kms.CustomKeyStoreTypeAwsCloudhsm || d.HasChanges("xks_proxy_connectivity", "xks_proxy_uri_endpoint", "xks_proxy_vpc_endpoint_service_name") {
+			disconnected, err := disconnectCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+			if err != nil {
+				return err
+			}
+
+			reconnect = disconnected
+		}
+
+		log.Printf("[DEBUG] Updating KMS Custom Key Store: %s", input)
+		if _, err := conn.UpdateCustomKeyStore(input); err != nil {
+			return fmt.Errorf("error updating KMS Custom Key Store (%s): %w", d.Id(), err)
+		}
+	}
+
+	if connected := d.Get("connected").(bool); connected && (reconnect || d.HasChange("connected")) {
+		if err := connectCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	} else if !connected && d.HasChange("connected") {
+		if _, err := disconnectCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomKeyStoreRead(d, meta)
+}
+
+func resourceCustomKeyStoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	// A custom key store must be disconnected before it can be deleted.
+	if _, err := disconnectCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting KMS Custom Key Store: %s", d.Id())
+	_, err := conn.DeleteCustomKeyStore(&kms.DeleteCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeCustomKeyStoreNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting KMS Custom Key Store (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// connectCustomKeyStore connects the specified custom key store and waits for the connection to complete.
+// A key store whose previous connection attempt failed is disconnected first, as AWS requires.
+func connectCustomKeyStore(conn *kms.KMS, id string, timeout time.Duration) error {
+	output, err := FindCustomKeyStoreByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): %w", id, err)
+	}
+
+	state := aws.StringValue(output.ConnectionState)
+
+	if state == kms.ConnectionStateTypeConnected {
+		return nil
+	}
+
+	if state != kms.ConnectionStateTypeConnecting {
+		if state == kms.ConnectionStateTypeFailed {
+			if _, err := disconnectCustomKeyStore(conn, id, timeout); err != nil {
+				return err
+			}
+		}
+
+		log.Printf("[DEBUG] Connecting KMS Custom Key Store: %s", id)
+		_, err := conn.ConnectCustomKeyStore(&kms.ConnectCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(id),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error connecting KMS Custom Key Store (%s): %w", id, err)
+		}
+	}
+
+	if _, err := WaitCustomKeyStoreConnected(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for KMS Custom Key Store (%s) to connect: %w", id, err)
+	}
+
+	return nil
+}
+
+// disconnectCustomKeyStore disconnects the specified custom key store and waits for the disconnection to complete.
+// It returns whether the key store was connected (or connecting) beforehand.
+func disconnectCustomKeyStore(conn *kms.KMS, id string, timeout time.Duration) (bool, error) {
+	output, err := FindCustomKeyStoreByID(conn, id)
+
+	if tfresource.NotFound(err) {
+		return false, err
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("error reading KMS Custom Key Store (%s): %w", id, err)
+	}
+
+	state := aws.StringValue(output.ConnectionState)
+
+	if state == kms.ConnectionStateTypeDisconnected {
+		return false, nil
+	}
+
+	if state != kms.ConnectionStateTypeDisconnecting {
+		log.Printf("[DEBUG] Disconnecting KMS Custom Key Store: %s", id)
+		_, err := conn.DisconnectCustomKeyStore(&kms.DisconnectCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(id),
+		})
+
+		if err != nil {
+			return false, fmt.Errorf("error disconnecting KMS Custom Key Store (%s): %w", id, err)
+		}
+	}
+
+	if _, err := WaitCustomKeyStoreDisconnected(conn, id, timeout); err != nil {
+		return false, fmt.Errorf("error waiting for KMS Custom Key Store (%s) to disconnect: %w", id, err)
+	}
+
+	return state == kms.ConnectionStateTypeConnected || state == kms.ConnectionStateTypeConnecting, nil
+}
+
+func expandXksProxyAuthenticationCredential(tfMap map[string]interface{}) *kms.XksProxyAuthenticationCredentialType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kms.XksProxyAuthenticationCredentialType{}
+
+	if v, ok := tfMap["access_key_id"].(string); ok && v != "" {
+		apiObject.AccessKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["raw_secret_access_key"].(string); ok && v != "" {
+		apiObject.RawSecretAccessKey = aws.String(v)
+	}
+
+	return apiObject
+}
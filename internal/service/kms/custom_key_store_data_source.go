@@ -0,0 +1,151 @@
+package kms
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceCustomKeyStore() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCustomKeyStoreRead,
+		Schema: map[string]*schema.Schema{
+			"cloud_hsm_cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_key_store_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"custom_key_store_id", "custom_key_store_name"},
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"custom_key_store_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"custom_key_store_id", "custom_key_store_name"},
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"custom_key_store_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trust_anchor_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"xks_proxy_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connectivity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uri_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uri_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_endpoint_service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCustomKeyStoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	var output *kms.CustomKeyStoresListEntry
+	var err error
+
+	if v, ok := d.GetOk("custom_key_store_id"); ok {
+		output, err = FindCustomKeyStoreByID(conn, v.(string))
+
+		if err != nil {
+			return fmt.Errorf("error reading KMS Custom Key Store (%s): %w", v.(string), err)
+		}
+	} else {
+		name := d.Get("custom_key_store_name").(string)
+		output, err = FindCustomKeyStoreByName(conn, name)
+
+		if err != nil {
+			return fmt.Errorf("error reading KMS Custom Key Store (%s): %w", name, err)
+		}
+	}
+
+	d.SetId(aws.StringValue(output.CustomKeyStoreId))
+	d.Set("cloud_hsm_cluster_id", output.CloudHsmClusterId)
+	d.Set("connection_error_code", output.ConnectionErrorCode)
+	d.Set("connection_state", output.ConnectionState)
+	if output.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	}
+	d.Set("custom_key_store_id", output.CustomKeyStoreId)
+	d.Set("custom_key_store_name", output.CustomKeyStoreName)
+	d.Set("custom_key_store_type", output.CustomKeyStoreType)
+	d.Set("trust_anchor_certificate", output.TrustAnchorCertificate)
+	if output.XksProxyConfiguration != nil {
+		if err := d.Set("xks_proxy_configuration", []interface{}{flattenXksProxyConfiguration(output.XksProxyConfiguration)}); err != nil {
+			return fmt.Errorf("error setting xks_proxy_configuration: %w", err)
+		}
+	} else {
+		d.Set("xks_proxy_configuration", nil)
+	}
+
+	return nil
+}
+
+func flattenXksProxyConfiguration(apiObject *kms.XksProxyConfigurationType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Connectivity; v != nil {
+		tfMap["connectivity"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UriEndpoint; v != nil {
+		tfMap["uri_endpoint"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UriPath; v != nil {
+		tfMap["uri_path"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VpcEndpointServiceName; v != nil {
+		tfMap["vpc_endpoint_service_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
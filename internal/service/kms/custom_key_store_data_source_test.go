@@ -0,0 +1,57 @@
+package kms_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccKMSCustomKeyStoreDataSource_basic(t *testing.T) {
+	uriEndpoint := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxyURIEndpoint, EnvVarKMSXksProxyMessageError)
+	uriPath := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxyURIPath, EnvVarKMSXksProxyMessageError)
+	accessKeyID := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxyAccessKeyID, EnvVarKMSXksProxyMessageError)
+	secretAccessKey := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxySecretAccessKey, EnvVarKMSXksProxyMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+	dataSourceByIDName := "data.aws_kms_custom_key_store.by_id"
+	dataSourceByNameName := "data.aws_kms_custom_key_store.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreDataSourceConfig_basic(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "connection_state", resourceName, "connection_state"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "creation_date", resourceName, "creation_date"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "custom_key_store_name", resourceName, "custom_key_store_name"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "custom_key_store_type", resourceName, "custom_key_store_type"),
+					resource.TestCheckResourceAttr(dataSourceByIDName, "xks_proxy_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "xks_proxy_configuration.0.connectivity", resourceName, "xks_proxy_connectivity"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "xks_proxy_configuration.0.uri_endpoint", resourceName, "xks_proxy_uri_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "xks_proxy_configuration.0.uri_path", resourceName, "xks_proxy_uri_path"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "custom_key_store_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "custom_key_store_type", resourceName, "custom_key_store_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomKeyStoreDataSourceConfig_basic(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey string) string {
+	return acctest.ConfigCompose(testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, false), `
+data "aws_kms_custom_key_store" "by_id" {
+  custom_key_store_id = aws_kms_custom_key_store.test.id
+}
+
+data "aws_kms_custom_key_store" "by_name" {
+  custom_key_store_name = aws_kms_custom_key_store.test.custom_key_store_name
+}
+`)
+}
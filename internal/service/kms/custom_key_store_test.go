@@ -0,0 +1,242 @@
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	EnvVarKMSCloudHSMClusterID              = "AWS_KMS_CLOUDHSM_CLUSTER_ID"
+	EnvVarKMSCloudHSMTrustAnchorCertificate = "AWS_KMS_CLOUDHSM_TRUST_ANCHOR_CERTIFICATE_PATH"
+	EnvVarKMSCloudHSMKeyStorePassword       = "AWS_KMS_CLOUDHSM_KEY_STORE_PASSWORD"
+	EnvVarKMSCloudHSMMessageError           = "Environment variables AWS_KMS_CLOUDHSM_CLUSTER_ID, AWS_KMS_CLOUDHSM_TRUST_ANCHOR_CERTIFICATE_PATH " +
+		"and AWS_KMS_CLOUDHSM_KEY_STORE_PASSWORD must be set to test AWS CloudHSM key stores with an initialized, active cluster."
+
+	EnvVarKMSXksProxyURIEndpoint     = "AWS_KMS_XKS_PROXY_URI_ENDPOINT"
+	EnvVarKMSXksProxyURIPath         = "AWS_KMS_XKS_PROXY_URI_PATH"
+	EnvVarKMSXksProxyAccessKeyID     = "AWS_KMS_XKS_PROXY_ACCESS_KEY_ID"
+	EnvVarKMSXksProxySecretAccessKey = "AWS_KMS_XKS_PROXY_SECRET_ACCESS_KEY"
+	EnvVarKMSXksProxyMessageError    = "Environment variables AWS_KMS_XKS_PROXY_URI_ENDPOINT, AWS_KMS_XKS_PROXY_URI_PATH, " +
+		"AWS_KMS_XKS_PROXY_ACCESS_KEY_ID and AWS_KMS_XKS_PROXY_SECRET_ACCESS_KEY must be set to test external key stores with a public endpoint key store proxy."
+
+	EnvVarKMSXksCustomKeyStoreID = "AWS_KMS_XKS_CUSTOM_KEY_STORE_ID"
+	EnvVarKMSXksKeyID            = "AWS_KMS_XKS_KEY_ID"
+	EnvVarKMSXksKeyMessageError  = "Environment variables AWS_KMS_XKS_CUSTOM_KEY_STORE_ID and AWS_KMS_XKS_KEY_ID must be set " +
+		"to test KMS keys in a connected external key store."
+)
+
+func TestAccKMSCustomKeyStore_cloudHSM(t *testing.T) {
+	var customKeyStore kms.CustomKeyStoresListEntry
+	clusterID := conns.SkipIfEnvVarEmpty(t, EnvVarKMSCloudHSMClusterID, EnvVarKMSCloudHSMMessageError)
+	certificatePath := conns.SkipIfEnvVarEmpty(t, EnvVarKMSCloudHSMTrustAnchorCertificate, EnvVarKMSCloudHSMMessageError)
+	password := conns.SkipIfEnvVarEmpty(t, EnvVarKMSCloudHSMKeyStorePassword, EnvVarKMSCloudHSMMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_cloudHSM(rName, clusterID, certificatePath, password, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "cloud_hsm_cluster_id", clusterID),
+					resource.TestCheckResourceAttr(resourceName, "connected", "false"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_type", kms.CustomKeyStoreTypeAwsCloudhsm),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_store_password"},
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_cloudHSM(rNameUpdated, clusterID, certificatePath, password, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "connected", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeConnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rNameUpdated),
+				),
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_cloudHSM(rNameUpdated, clusterID, certificatePath, password, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "connected", "false"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSCustomKeyStore_externalKeyStore(t *testing.T) {
+	var customKeyStore kms.CustomKeyStoresListEntry
+	uriEndpoint := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxyURIEndpoint, EnvVarKMSXksProxyMessageError)
+	uriPath := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxyURIPath, EnvVarKMSXksProxyMessageError)
+	accessKeyID := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxyAccessKeyID, EnvVarKMSXksProxyMessageError)
+	secretAccessKey := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxySecretAccessKey, EnvVarKMSXksProxyMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "connected", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeConnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_type", kms.CustomKeyStoreTypeExternalKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_connectivity", kms.XksProxyConnectivityTypePublicEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_endpoint", uriEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_path", uriPath),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"xks_proxy_authentication_credential"},
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rNameUpdated, uriEndpoint, uriPath, accessKeyID, secretAccessKey, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "connected", "true"),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSCustomKeyStore_disappears(t *testing.T) {
+	var customKeyStore kms.CustomKeyStoresListEntry
+	uriEndpoint := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxyURIEndpoint, EnvVarKMSXksProxyMessageError)
+	uriPath := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxyURIPath, EnvVarKMSXksProxyMessageError)
+	accessKeyID := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxyAccessKeyID, EnvVarKMSXksProxyMessageError)
+	secretAccessKey := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksProxySecretAccessKey, EnvVarKMSXksProxyMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customKeyStore),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkms.ResourceCustomKeyStore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomKeyStoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_custom_key_store" {
+			continue
+		}
+
+		_, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("KMS Custom Key Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCustomKeyStoreExists(name string, customKeyStore *kms.CustomKeyStoresListEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Custom Key Store ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+		output, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*customKeyStore = *output
+
+		return nil
+	}
+}
+
+func testAccCustomKeyStoreConfig_cloudHSM(rName, clusterID, certificatePath, password string, connected bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name    = %[1]q
+  cloud_hsm_cluster_id     = %[2]q
+  trust_anchor_certificate = file(%[3]q)
+  key_store_password       = %[4]q
+  connected                = %[5]t
+}
+`, rName, clusterID, certificatePath, password, connected)
+}
+
+func testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey string, connected bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name  = %[1]q
+  custom_key_store_type  = "EXTERNAL_KEY_STORE"
+  xks_proxy_connectivity = "PUBLIC_ENDPOINT"
+  xks_proxy_uri_endpoint = %[2]q
+  xks_proxy_uri_path     = %[3]q
+  connected              = %[6]t
+
+  xks_proxy_authentication_credential {
+    access_key_id         = %[4]q
+    raw_secret_access_key = %[5]q
+  }
+}
+`, rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, connected)
+}
@@ -39,6 +39,47 @@ func FindAliasByName(conn *kms.KMS, name string) (*kms.AliasListEntry, error) {
 	return output, nil
 }
 
+func FindCustomKeyStoreByID(conn *kms.KMS, id string) (*kms.CustomKeyStoresListEntry, error) {
+	input := &kms.DescribeCustomKeyStoresInput{
+		CustomKeyStoreId: aws.String(id),
+	}
+
+	return findCustomKeyStore(conn, input)
+}
+
+func FindCustomKeyStoreByName(conn *kms.KMS, name string) (*kms.CustomKeyStoresListEntry, error) {
+	input := &kms.DescribeCustomKeyStoresInput{
+		CustomKeyStoreName: aws.String(name),
+	}
+
+	return findCustomKeyStore(conn, input)
+}
+
+func findCustomKeyStore(conn *kms.KMS, input *kms.DescribeCustomKeyStoresInput) (*kms.CustomKeyStoresListEntry, error) {
+	output, err := conn.DescribeCustomKeyStores(input)
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeCustomKeyStoreNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CustomKeyStores) == 0 || output.CustomKeyStores[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.CustomKeyStores); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.CustomKeyStores[0], nil
+}
+
 func FindKeyByID(conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
 	input := &kms.DescribeKeyInput{
 		KeyId: aws.String(id),
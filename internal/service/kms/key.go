@@ -40,6 +40,13 @@ func ResourceKey() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"custom_key_store_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
 			"customer_master_key_spec": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -94,6 +101,14 @@ func ResourceKey() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"xks_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"custom_key_store_id"},
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
 		},
 	}
 }
@@ -109,6 +124,18 @@ func resourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		KeyUsage:                       aws.String(d.Get("key_usage").(string)),
 	}
 
+	if v, ok := d.GetOk("custom_key_store_id"); ok {
+		// The key's origin must match the type of its custom key store.
+		customKeyStore, err := FindCustomKeyStoreByID(conn, v.(string))
+
+		if err != nil {
+			return fmt.Errorf("error reading KMS Custom Key Store (%s): %w", v.(string), err)
+		}
+
+		input.CustomKeyStoreId = aws.String(v.(string))
+		input.Origin = customKeyStore.CustomKeyStoreType
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -125,6 +152,10 @@ func resourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	if v, ok := d.GetOk("xks_key_id"); ok {
+		input.XksKeyId = aws.String(v.(string))
+	}
+
 	// AWS requires any principal in the policy to exist before the key is created.
 	// The KMS service's awareness of principals is limited by "eventual consistency".
 	// They acknowledge this here:
@@ -192,6 +223,7 @@ func resourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("arn", key.metadata.Arn)
+	d.Set("custom_key_store_id", key.metadata.CustomKeyStoreId)
 	d.Set("customer_master_key_spec", key.metadata.CustomerMasterKeySpec)
 	d.Set("description", key.metadata.Description)
 	d.Set("enable_key_rotation", key.rotation)
@@ -207,6 +239,11 @@ func resourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("policy", policyToSet)
+	if key.metadata.XksKeyConfiguration != nil {
+		d.Set("xks_key_id", key.metadata.XksKeyConfiguration.Id)
+	} else {
+		d.Set("xks_key_id", nil)
+	}
 
 	tags := key.tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
@@ -98,6 +98,37 @@ func TestAccKMSKey_multiRegion(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_externalKeyStore(t *testing.T) {
+	var key kms.KeyMetadata
+	customKeyStoreID := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksCustomKeyStoreID, EnvVarKMSXksKeyMessageError)
+	xksKeyID := conns.SkipIfEnvVarEmpty(t, EnvVarKMSXksKeyID, EnvVarKMSXksKeyMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKey_externalKeyStore(rName, customKeyStoreID, xksKeyID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_id", customKeyStoreID),
+					resource.TestCheckResourceAttr(resourceName, "xks_key_id", xksKeyID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+			},
+		},
+	})
+}
+
 func TestAccKMSKey_asymmetricKey(t *testing.T) {
 	var key kms.KeyMetadata
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -520,6 +551,17 @@ resource "aws_kms_key" "test" {}
 `
 }
 
+func testAccKey_externalKeyStore(rName, customKeyStoreID, xksKeyID string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  custom_key_store_id     = %[2]q
+  xks_key_id              = %[3]q
+}
+`, rName, customKeyStoreID, xksKeyID)
+}
+
 func testAccKeyNameConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
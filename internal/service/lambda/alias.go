@@ -3,13 +3,19 @@ package lambda
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceAlias() *schema.Resource {
@@ -22,6 +28,10 @@ func ResourceAlias() *schema.Resource {
 			State: resourceAliasImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:     schema.TypeString,
@@ -56,6 +66,36 @@ func ResourceAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"progressive_deployment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"step_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"step_weights": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeFloat,
+								ValidateFunc: validation.FloatBetween(0.0, 1.0),
+							},
+						},
+					},
+				},
+			},
 			"routing_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -166,6 +206,16 @@ func resourceAliasDelete(d *schema.ResourceData, meta interface{}) error {
 func resourceAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LambdaConn
 
+	if o, n := d.GetChange("function_version"); d.HasChange("function_version") && o.(string) != "" {
+		if v, ok := d.GetOk("progressive_deployment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := shiftAliasTraffic(conn, meta.(*conns.AWSClient).CloudWatchConn, d, o.(string), n.(string), v.([]interface{})[0].(map[string]interface{})); err != nil {
+				// The alias still points at the previous version.
+				d.Set("function_version", o)
+				return err
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Updating Lambda alias: %s:%s", d.Get("function_name"), d.Get("name"))
 
 	params := &lambda.UpdateAliasInput{
@@ -184,6 +234,75 @@ func resourceAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// shiftAliasTraffic gradually routes traffic from the alias' current version to the
+// new version using the configured step weights. Between steps it watches the
+// configured CloudWatch alarms and, if any of them fires or the update timeout is
+// reached, routes all traffic back to the current version and returns an error.
+func shiftAliasTraffic(conn *lambda.Lambda, cloudwatchConn *cloudwatch.CloudWatch, d *schema.ResourceData, oldVersion, newVersion string, tfMap map[string]interface{}) error {
+	functionName := d.Get("function_name").(string)
+	aliasName := d.Get("name").(string)
+	id := fmt.Sprintf("%s:%s", functionName, aliasName)
+
+	weights := make([]float64, 0)
+	for _, v := range tfMap["step_weights"].([]interface{}) {
+		weights = append(weights, v.(float64))
+	}
+	sort.Float64s(weights)
+
+	interval := time.Duration(tfMap["step_interval"].(int)) * time.Second
+	alarmNames := flex.ExpandStringSet(tfMap["alarm_names"].(*schema.Set))
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if total := time.Duration(len(weights)) * interval; total > timeout {
+		return fmt.Errorf("Lambda Alias (%s) progressive deployment of %d steps of %s (%s) exceeds the update timeout (%s)", id, len(weights), interval, total, timeout)
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for _, weight := range weights {
+		log.Printf("[INFO] Shifting %.2f of Lambda Alias (%s) traffic to version %s", weight, id, newVersion)
+		_, err := conn.UpdateAlias(&lambda.UpdateAliasInput{
+			FunctionName:    aws.String(functionName),
+			FunctionVersion: aws.String(oldVersion),
+			Name:            aws.String(aliasName),
+			RoutingConfig: &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]*float64{newVersion: aws.Float64(weight)},
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("error shifting Lambda Alias (%s) traffic to version %s: %w", id, newVersion, err)
+		}
+
+		err = waitAliasTrafficShiftStep(cloudwatchConn, alarmNames, interval, time.Until(deadline))
+
+		if tfresource.TimedOut(err) {
+			err = fmt.Errorf("update timeout (%s) reached", timeout)
+		}
+
+		if err == nil {
+			continue
+		}
+
+		log.Printf("[WARN] Rolling back Lambda Alias (%s) to version %s: %s", id, oldVersion, err)
+		_, rollbackErr := conn.UpdateAlias(&lambda.UpdateAliasInput{
+			FunctionName:    aws.String(functionName),
+			FunctionVersion: aws.String(oldVersion),
+			Name:            aws.String(aliasName),
+			RoutingConfig:   &lambda.AliasRoutingConfiguration{},
+		})
+
+		if rollbackErr != nil {
+			return fmt.Errorf("error rolling back Lambda Alias (%s) to version %s after deployment of version %s failed at weight %.2f (%s): %w", id, oldVersion, newVersion, weight, err, rollbackErr)
+		}
+
+		return fmt.Errorf("Lambda Alias (%s) deployment of version %s rolled back to version %s at weight %.2f: %w", id, newVersion, oldVersion, weight, err)
+	}
+
+	return nil
+}
+
 func expandAliasRoutingConfiguration(l []interface{}) *lambda.AliasRoutingConfiguration {
 	aliasRoutingConfiguration := &lambda.AliasRoutingConfiguration{}
 
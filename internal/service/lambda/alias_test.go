@@ -2,6 +2,7 @@ package lambda_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -190,6 +191,77 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 	})
 }
 
+func TestAccLambdaAlias_progressiveDeployment(t *testing.T) {
+	var conf lambda.AliasConfiguration
+	resourceName := "aws_lambda_alias.test"
+
+	rString := sdkacctest.RandString(8)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_alias_progressive_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_alias_progressive_%s", rString)
+	attachmentName := fmt.Sprintf("tf_acc_attachment_%s", rString)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_alias_progressive_%s", rString)
+	aliasName := fmt.Sprintf("tf_acc_lambda_alias_progressive_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasProgressiveDeploymentConfig(roleName, policyName, attachmentName, funcName, aliasName, "lambdatest.zip", "notBreaching"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progressive_deployment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progressive_deployment.0.step_weights.#", "2"),
+				),
+			},
+			{
+				Config: testAccAliasProgressiveDeploymentConfig(roleName, policyName, attachmentName, funcName, aliasName, "lambdatest_modified.zip", "notBreaching"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaAlias_progressiveDeploymentRollback(t *testing.T) {
+	var conf lambda.AliasConfiguration
+	resourceName := "aws_lambda_alias.test"
+
+	rString := sdkacctest.RandString(8)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_alias_rollback_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_alias_rollback_%s", rString)
+	attachmentName := fmt.Sprintf("tf_acc_attachment_%s", rString)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_alias_rollback_%s", rString)
+	aliasName := fmt.Sprintf("tf_acc_lambda_alias_rollback_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Missing data is treated as breaching, so the alarm fires once evaluated.
+				Config: testAccAliasProgressiveDeploymentConfig(roleName, policyName, attachmentName, funcName, aliasName, "lambdatest.zip", "breaching"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+				),
+			},
+			{
+				Config:      testAccAliasProgressiveDeploymentConfig(roleName, policyName, attachmentName, funcName, aliasName, "lambdatest_modified.zip", "breaching"),
+				ExpectError: regexp.MustCompile(`rolled back to version 1`),
+			},
+		},
+	})
+}
+
 func testAccCheckAliasDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
 
@@ -422,3 +494,47 @@ resource "aws_lambda_alias" "test" {
 }
 `, funcName, aliasName))
 }
+
+func testAccAliasProgressiveDeploymentConfig(roleName, policyName, attachmentName, funcName, aliasName, filename, treatMissingData string) string {
+	return acctest.ConfigCompose(
+		testAccAliasBaseConfig(roleName, policyName, attachmentName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename         = "test-fixtures/%[3]s"
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs12.x"
+  source_code_hash = filebase64sha256("test-fixtures/%[3]s")
+  publish          = true
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = "Errors"
+  namespace           = "AWS/Lambda"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 0
+  treat_missing_data  = %[4]q
+
+  dimensions = {
+    FunctionName = aws_lambda_function.test.function_name
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[2]q
+  function_name    = aws_lambda_function.test.arn
+  function_version = aws_lambda_function.test.version
+
+  progressive_deployment {
+    alarm_names   = [aws_cloudwatch_metric_alarm.test.alarm_name]
+    step_interval = 90
+    step_weights  = [0.1, 0.5]
+  }
+}
+`, funcName, aliasName, filename, treatMissingData))
+}
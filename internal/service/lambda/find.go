@@ -2,6 +2,7 @@ package lambda
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -43,3 +44,35 @@ func FindEventSourceMappingConfiguration(conn *lambda.Lambda, input *lambda.GetE
 
 	return output, nil
 }
+
+// findAlarmsInAlarmState returns the names of those specified metric or composite alarms that are in the ALARM state.
+func findAlarmsInAlarmState(conn *cloudwatch.CloudWatch, alarmNames []*string) ([]string, error) {
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarmNames,
+		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeCompositeAlarm, cloudwatch.AlarmTypeMetricAlarm}),
+		StateValue: aws.String(cloudwatch.StateValueAlarm),
+	}
+	var names []string
+
+	err := conn.DescribeAlarmsPages(input, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, alarm := range page.MetricAlarms {
+			names = append(names, aws.StringValue(alarm.AlarmName))
+		}
+
+		for _, alarm := range page.CompositeAlarms {
+			names = append(names, aws.StringValue(alarm.AlarmName))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
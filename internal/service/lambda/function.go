@@ -62,27 +62,27 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_dir"},
 			},
 			"s3_bucket": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"image_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_dir"},
 			},
 			"package_type": {
 				Type:         schema.TypeString,
@@ -306,6 +306,22 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "image_uri"},
+			},
+			"source_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_staging_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			"environment": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -349,6 +365,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			setSourceCodeHashFromSourceDir,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -419,13 +436,27 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 	imageUri, hasImageUri := d.GetOk("image_uri")
+	_, hasSourceDir := d.GetOk("source_dir")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri {
-		return errors.New("filename, s3_* or image_uri attributes must be set")
+	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri && !hasSourceDir {
+		return errors.New("filename, s3_*, image_uri or source_dir attributes must be set")
 	}
 
 	var functionCode *lambda.FunctionCode
-	if hasFilename {
+	if hasSourceDir {
+		// Packages are built in memory, so only build one at a time.
+		conns.GlobalMutexKV.Lock(awsMutexLambdaKey)
+		defer conns.GlobalMutexKV.Unlock(awsMutexLambdaKey)
+		location, err := prepareSourcePackage(d, meta, functionName)
+		if err != nil {
+			return fmt.Errorf("unable to package %q: %w", d.Get("source_dir").(string), err)
+		}
+		functionCode = &lambda.FunctionCode{
+			ZipFile:  location.ZipFile,
+			S3Bucket: location.S3Bucket,
+			S3Key:    location.S3Key,
+		}
+	} else if hasFilename {
 		// Grab an exclusive lock so that we're only reading one function into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
@@ -963,6 +994,7 @@ func resourceFunctionDelete(d *schema.ResourceData, meta interface{}) error {
 
 func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
+		d.HasChange("source_dir") ||
 		d.HasChange("source_code_hash") ||
 		d.HasChange("s3_bucket") ||
 		d.HasChange("s3_key") ||
@@ -1205,7 +1237,18 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		if v, ok := d.GetOk("filename"); ok {
+		if v, ok := d.GetOk("source_dir"); ok {
+			// Packages are built in memory, so only build one at a time.
+			conns.GlobalMutexKV.Lock(awsMutexLambdaKey)
+			defer conns.GlobalMutexKV.Unlock(awsMutexLambdaKey)
+			location, err := prepareSourcePackage(d, meta, d.Id())
+			if err != nil {
+				return fmt.Errorf("unable to package %q: %w", v.(string), err)
+			}
+			codeReq.ZipFile = location.ZipFile
+			codeReq.S3Bucket = location.S3Bucket
+			codeReq.S3Key = location.S3Key
+		} else if v, ok := d.GetOk("filename"); ok {
 			// Grab an exclusive lock so that we're only reading one function into
 			// memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
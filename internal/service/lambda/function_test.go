@@ -76,6 +76,46 @@ func TestAccLambdaFunction_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	resourceName := "aws_lambda_function.test"
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_source_dir_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_source_dir_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_source_dir_%s", rString)
+	sgName := fmt.Sprintf("tf_acc_sg_lambda_func_source_dir_%s", rString)
+
+	pkg, err := tflambda.BuildSourcePackage("test-fixtures/lambda_source_dir", []string{"*.md"})
+
+	if err != nil {
+		t.Fatalf("error building package: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceDirConfig(funcName, policyName, roleName, sgName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "source_code_hash", pkg.Hash),
+					resource.TestCheckResourceAttr(resourceName, "source_excludes.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_dir", "source_excludes"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_unpublishedCodeUpdate(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -2388,6 +2428,19 @@ func createTempFile(prefix string) (string, *os.File, error) {
 	return pathToFile, f, nil
 }
 
+func testAccSourceDirConfig(funcName, policyName, roleName, sgName string) string {
+	return fmt.Sprintf(acctest.ConfigLambdaBase(policyName, roleName, sgName)+`
+resource "aws_lambda_function" "test" {
+  source_dir      = "test-fixtures/lambda_source_dir"
+  source_excludes = ["*.md"]
+  function_name   = "%s"
+  role            = aws_iam_role.iam_for_lambda.arn
+  handler         = "index.handler"
+  runtime         = "nodejs12.x"
+}
+`, funcName)
+}
+
 func testAccBasicConfig(funcName, policyName, roleName, sgName string) string {
 	return fmt.Sprintf(acctest.ConfigLambdaBase(policyName, roleName, sgName)+`
 resource "aws_lambda_function" "test" {
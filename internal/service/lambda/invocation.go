@@ -1,21 +1,43 @@
 package lambda
 
 import (
+	"context"
 	"crypto/md5"
+	"encoding/json"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
+const (
+	invocationLifecycleScopeCreateOnly = "CREATE_ONLY"
+	invocationLifecycleScopeCRUD       = "CRUD"
+)
+
+func invocationLifecycleScope_Values() []string {
+	return []string{
+		invocationLifecycleScopeCreateOnly,
+		invocationLifecycleScopeCRUD,
+	}
+}
+
+const (
+	invocationActionCreate = "create"
+	invocationActionUpdate = "update"
+	invocationActionDelete = "delete"
+)
+
 func ResourceInvocation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceInvocationCreate,
 		Read:   resourceInvocationRead,
+		Update: resourceInvocationUpdate,
 		Delete: resourceInvocationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -27,9 +49,14 @@ func ResourceInvocation() *schema.Resource {
 			"input": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsJSON,
 			},
+			"lifecycle_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      invocationLifecycleScopeCreateOnly,
+				ValidateFunc: validation.StringInSlice(invocationLifecycleScope_Values(), false),
+			},
 			"qualifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -43,10 +70,18 @@ func ResourceInvocation() *schema.Resource {
 			"triggers": {
 				Type:     schema.TypeMap,
 				Optional: true,
-				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// Without CRUD lifecycle scope, any change re-invokes the function as a new resource.
+			customdiff.ForceNewIf("input", invocationIsCreateOnly),
+			customdiff.ForceNewIf("triggers", invocationIsCreateOnly),
+			customdiff.ComputedIf("result", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChanges("input", "triggers")
+			}),
+		),
 	}
 }
 
@@ -57,32 +92,138 @@ func resourceInvocationCreate(d *schema.ResourceData, meta interface{}) error {
 	qualifier := d.Get("qualifier").(string)
 	input := []byte(d.Get("input").(string))
 
-	res, err := conn.Invoke(&lambda.InvokeInput{
-		FunctionName:   aws.String(functionName),
-		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
-		Payload:        input,
-		Qualifier:      aws.String(qualifier),
-	})
+	payload := input
 
-	if err != nil {
-		return fmt.Errorf("Lambda Invocation (%s) failed: %w", d.Id(), err)
+	if d.Get("lifecycle_scope").(string) == invocationLifecycleScopeCRUD {
+		var err error
+		payload, err = InvocationLifecyclePayload(invocationActionCreate, "", string(input))
+
+		if err != nil {
+			return err
+		}
 	}
 
-	if res.FunctionError != nil {
-		return fmt.Errorf("Lambda function (%s) returned error: (%s)", functionName, string(res.Payload))
+	result, err := invokeFunction(conn, functionName, qualifier, payload)
+
+	if err != nil {
+		return err
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s_%x", functionName, qualifier, md5.Sum(input)))
-	d.Set("result", string(res.Payload))
+	d.Set("result", result)
 
 	return nil
 }
 
 func resourceInvocationRead(d *schema.ResourceData, meta interface{}) error {
+	// Invocations created before lifecycle_scope was introduced have no value in state.
+	if d.Get("lifecycle_scope").(string) == "" {
+		d.Set("lifecycle_scope", invocationLifecycleScopeCreateOnly)
+	}
+
 	return nil
 }
 
-func resourceInvocationDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[DEBUG] Lambda Invocation (%s) \"deleted\" by removing from state", d.Id())
+func resourceInvocationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	// Changing lifecycle_scope alone only affects how later changes are applied.
+	if !d.HasChanges("input", "triggers") {
+		return nil
+	}
+
+	o, n := d.GetChange("input")
+	payload, err := InvocationLifecyclePayload(invocationActionUpdate, o.(string), n.(string))
+
+	if err != nil {
+		return err
+	}
+
+	result, err := invokeFunction(conn, d.Get("function_name").(string), d.Get("qualifier").(string), payload)
+
+	if err != nil {
+		// Keep the previous input in state so that the update is retried on the next apply.
+		d.Partial(true)
+
+		return err
+	}
+
+	d.Set("result", result)
+
 	return nil
 }
+
+func resourceInvocationDelete(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("lifecycle_scope").(string) != invocationLifecycleScopeCRUD {
+		log.Printf("[DEBUG] Lambda Invocation (%s) \"deleted\" by removing from state", d.Id())
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	payload, err := InvocationLifecyclePayload(invocationActionDelete, d.Get("input").(string), "")
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lambda Invocation (%s)", d.Id())
+	_, err = invokeFunction(conn, d.Get("function_name").(string), d.Get("qualifier").(string), payload)
+
+	return err
+}
+
+func invocationIsCreateOnly(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+	return d.Get("lifecycle_scope").(string) != invocationLifecycleScopeCRUD
+}
+
+func invokeFunction(conn *lambda.Lambda, functionName, qualifier string, payload []byte) (string, error) {
+	res, err := conn.Invoke(&lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
+		Payload:        payload,
+		Qualifier:      aws.String(qualifier),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("Lambda Invocation (%s) failed: %w", functionName, err)
+	}
+
+	if res.FunctionError != nil {
+		return "", fmt.Errorf("Lambda function (%s) returned error: (%s)", functionName, string(res.Payload))
+	}
+
+	return string(res.Payload), nil
+}
+
+// InvocationLifecyclePayload wraps the previous and new input documents in the
+// envelope passed to the function in the CRUD lifecycle scope:
+//
+//	{"action": "create|update|delete", "old_input": {...}, "new_input": {...}}
+//
+// old_input is omitted on create and new_input is omitted on delete.
+func InvocationLifecyclePayload(action, oldInput, newInput string) ([]byte, error) {
+	envelope := struct {
+		Action   string          `json:"action"`
+		OldInput json.RawMessage `json:"old_input,omitempty"`
+		NewInput json.RawMessage `json:"new_input,omitempty"`
+	}{
+		Action: action,
+	}
+
+	if oldInput != "" {
+		envelope.OldInput = json.RawMessage(oldInput)
+	}
+
+	if newInput != "" {
+		envelope.NewInput = json.RawMessage(newInput)
+	}
+
+	payload, err := json.Marshal(envelope)
+
+	if err != nil {
+		return nil, fmt.Errorf("error building Lambda Invocation %s payload: %w", action, err)
+	}
+
+	return payload, nil
+}
@@ -9,8 +9,53 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 )
 
+func TestInvocationLifecyclePayload(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Action   string
+		OldInput string
+		NewInput string
+		Expected string
+	}{
+		{
+			Name:     "create",
+			Action:   "create",
+			NewInput: `{"key1":"value1"}`,
+			Expected: `{"action":"create","new_input":{"key1":"value1"}}`,
+		},
+		{
+			Name:     "update",
+			Action:   "update",
+			OldInput: `{"key1":"value1"}`,
+			NewInput: `{"key1":"value2"}`,
+			Expected: `{"action":"update","old_input":{"key1":"value1"},"new_input":{"key1":"value2"}}`,
+		},
+		{
+			Name:     "delete",
+			Action:   "delete",
+			OldInput: `{"key1": "value1"}`,
+			Expected: `{"action":"delete","old_input":{"key1":"value1"}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := tflambda.InvocationLifecyclePayload(testCase.Action, testCase.OldInput, testCase.NewInput)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != testCase.Expected {
+				t.Errorf("expected %s, got %s", testCase.Expected, string(got))
+			}
+		})
+	}
+}
+
 func TestAccLambdaInvocation_basic(t *testing.T) {
 	resourceName := "aws_lambda_invocation.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -108,6 +153,34 @@ func TestAccLambdaInvocation_triggers(t *testing.T) {
 	})
 }
 
+func TestAccLambdaInvocation_lifecycleScopeCRUD(t *testing.T) {
+	resourceName := "aws_lambda_invocation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	testData := "value3"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInvocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigInvocation_lifecycleScopeCRUD(rName, testData, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_scope", "CRUD"),
+					testAccCheckInvocationResult(resourceName, fmt.Sprintf(`{"action":"create","new_input":{"key1":"value1"},"key3":%q}`, testData)),
+				),
+			},
+			{
+				Config: testAccConfigInvocation_lifecycleScopeCRUD(rName, testData, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvocationResult(resourceName, fmt.Sprintf(`{"action":"update","old_input":{"key1":"value1"},"new_input":{"key1":"value2"},"key3":%q}`, testData)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInvocationDestroy(s *terraform.State) error {
 	// Nothing to check on destroy
 	return nil
@@ -287,3 +360,34 @@ resource "aws_lambda_invocation" "test" {
 }
 `, rName, testData))
 }
+
+func testAccConfigInvocation_lifecycleScopeCRUD(rName, testData, inputValue string) string {
+	return acctest.ConfigCompose(
+		testAccConfigInvocation_base(rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs14.x"
+
+  environment {
+    variables = {
+      TEST_DATA = %[2]q
+    }
+  }
+}
+
+resource "aws_lambda_invocation" "test" {
+  function_name   = aws_lambda_function.test.function_name
+  lifecycle_scope = "CRUD"
+
+  input = jsonencode({
+    key1 = %[3]q
+  })
+}
+`, rName, testData, inputValue))
+}
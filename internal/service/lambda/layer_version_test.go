@@ -52,6 +52,34 @@ func TestAccLambdaLayerVersion_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_sourceDir(t *testing.T) {
+	resourceName := "aws_lambda_layer_version.lambda_layer_test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	pkg, err := tflambda.BuildSourcePackage("test-fixtures/lambda_source_dir", nil)
+
+	if err != nil {
+		t.Fatalf("error building package: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLayerVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionSourceDir(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(resourceName, rName),
+					resource.TestCheckResourceAttr(resourceName, "source_code_hash", pkg.Hash),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaLayerVersion_update(t *testing.T) {
 	resourceName := "aws_lambda_layer_version.lambda_layer_test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -334,6 +362,15 @@ func testAccCheckLayerVersionExists(res, layerName string) resource.TestCheckFun
 	}
 }
 
+func testAccLayerVersionSourceDir(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "lambda_layer_test" {
+  source_dir = "test-fixtures/lambda_source_dir"
+  layer_name = %[1]q
+}
+`, rName)
+}
+
 func testAccLayerVersionBasic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lambda_layer_version" "lambda_layer_test" {
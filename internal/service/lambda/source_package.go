@@ -0,0 +1,261 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a deployment package that can be uploaded directly in the request.
+	sourcePackageDirectUploadLimit = 50 * 1024 * 1024
+)
+
+// sourcePackageModTime is the modification time recorded for every entry, the
+// earliest timestamp representable in a zip file header.
+var sourcePackageModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// SourcePackage is a deployment package built from a local source directory.
+type SourcePackage struct {
+	Content []byte
+	// Hash is the base64-encoded SHA-256 of Content, as reported by Lambda in CodeSha256.
+	Hash string
+}
+
+// BuildSourcePackage builds a reproducible zip archive of the regular files beneath dir.
+// Entries are sorted by path, timestamps are fixed and permissions are normalised to
+// 0644 or, for files with any executable bit set, 0755, so that the same tree always
+// produces the same archive regardless of the machine it was built on.
+// Paths matching any of the exclude glob patterns, relative to dir and using forward
+// slashes, are omitted. A pattern matching a directory excludes its contents.
+func BuildSourcePackage(dir string, excludes []string) (*SourcePackage, error) {
+	root, err := homedir.Expand(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range excludes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern %q: %w", pattern, err)
+		}
+	}
+
+	type entry struct {
+		name string
+		path string
+		mode os.FileMode
+	}
+
+	var entries []entry
+
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		name := filepath.ToSlash(rel)
+
+		if sourcePackageExcluded(name, excludes) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		// Symbolic links are followed by stat'ing the target.
+		if info.Mode()&os.ModeSymlink != 0 {
+			info, err = os.Stat(p)
+
+			if err != nil {
+				return err
+			}
+
+			if info.IsDir() {
+				return fmt.Errorf("symbolic link to directory is not supported: %s", name)
+			}
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		mode := os.FileMode(0644)
+		if info.Mode()&0111 != 0 {
+			mode = 0755
+		}
+
+		entries = append(entries, entry{name: name, path: p, mode: mode})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", dir, err)
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("source directory (%s) contains no files", dir)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, e := range entries {
+		header := &zip.FileHeader{
+			Name:     e.name,
+			Method:   zip.Deflate,
+			Modified: sourcePackageModTime,
+		}
+		header.SetMode(e.mode)
+
+		fw, err := w.CreateHeader(header)
+
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := os.Open(e.path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = io.Copy(fw, f)
+		f.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("adding %s to package: %w", e.name, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+
+	return &SourcePackage{
+		Content: buf.Bytes(),
+		Hash:    base64.StdEncoding.EncodeToString(sum[:]),
+	}, nil
+}
+
+func sourcePackageExcluded(name string, excludes []string) bool {
+	for _, pattern := range excludes {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+
+		// Also match against the base name so that patterns such as "*.pyc" apply at any depth.
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, path.Base(name)); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// setSourceCodeHashFromSourceDir plans source_code_hash from the package that will be
+// built from source_dir, so that any change to the directory contents produces a diff.
+func setSourceCodeHashFromSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("source_dir")
+
+	if !ok {
+		return nil
+	}
+
+	pkg, err := BuildSourcePackage(v.(string), aws.StringValueSlice(flex.ExpandStringSet(d.Get("source_excludes").(*schema.Set))))
+
+	if err != nil {
+		return fmt.Errorf("error building package from source_dir: %w", err)
+	}
+
+	if d.Get("source_code_hash").(string) == pkg.Hash {
+		return nil
+	}
+
+	return d.SetNew("source_code_hash", pkg.Hash)
+}
+
+// sourcePackageLocation is where a deployment package built from source_dir can be read by Lambda.
+type sourcePackageLocation struct {
+	ZipFile  []byte
+	S3Bucket *string
+	S3Key    *string
+}
+
+// prepareSourcePackage builds the package for source_dir and, when it exceeds the direct
+// upload limit, uploads it to the staging bucket under a content-addressed key.
+func prepareSourcePackage(d *schema.ResourceData, meta interface{}, keyPrefix string) (*sourcePackageLocation, error) {
+	pkg, err := BuildSourcePackage(d.Get("source_dir").(string), aws.StringValueSlice(flex.ExpandStringSet(d.Get("source_excludes").(*schema.Set))))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkg.Content) <= sourcePackageDirectUploadLimit {
+		return &sourcePackageLocation{ZipFile: pkg.Content}, nil
+	}
+
+	bucket := d.Get("source_staging_s3_bucket").(string)
+
+	if bucket == "" {
+		return nil, fmt.Errorf("package built from source_dir is %d bytes, which exceeds the direct upload limit of %d bytes: source_staging_s3_bucket must be set", len(pkg.Content), sourcePackageDirectUploadLimit)
+	}
+
+	hash, _ := base64.StdEncoding.DecodeString(pkg.Hash)
+	key := fmt.Sprintf("%s/%s.zip", keyPrefix, hex.EncodeToString(hash))
+
+	log.Printf("[DEBUG] Uploading Lambda deployment package to s3://%s/%s", bucket, key)
+	_, err = meta.(*conns.AWSClient).S3Conn.PutObject(&s3.PutObjectInput{
+		Body:   bytes.NewReader(pkg.Content),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading package to S3 Bucket (%s): %w", bucket, err)
+	}
+
+	return &sourcePackageLocation{
+		S3Bucket: aws.String(bucket),
+		S3Key:    aws.String(key),
+	}, nil
+}
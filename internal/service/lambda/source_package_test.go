@@ -0,0 +1,123 @@
+package lambda_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+)
+
+func TestBuildSourcePackage(t *testing.T) {
+	dir := t.TempDir()
+
+	testWriteSourceFile(t, dir, "index.js", "exports.handler = () => {};", 0600)
+	testWriteSourceFile(t, dir, "bin/tool", "#!/bin/sh", 0700)
+	testWriteSourceFile(t, dir, "lib/util.js", "module.exports = {};", 0664)
+	testWriteSourceFile(t, dir, "lib/util.pyc", "compiled", 0644)
+	testWriteSourceFile(t, dir, "node_modules/.cache/x", "cache", 0644)
+
+	pkg1, err := tflambda.BuildSourcePackage(dir, []string{"*.pyc", "node_modules/.cache"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(pkg1.Content), int64(len(pkg1.Content)))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []struct {
+		name string
+		mode os.FileMode
+	}{
+		{"bin/tool", 0755},
+		{"index.js", 0644},
+		{"lib/util.js", 0644},
+	}
+
+	if len(r.File) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(r.File))
+	}
+
+	for i, f := range r.File {
+		if f.Name != expected[i].name {
+			t.Errorf("entry %d: expected name %s, got %s", i, expected[i].name, f.Name)
+		}
+
+		if f.Mode() != expected[i].mode {
+			t.Errorf("entry %s: expected mode %s, got %s", f.Name, expected[i].mode, f.Mode())
+		}
+	}
+
+	// Touching files and changing permission bits other than executable must not change the package.
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), later, later); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := os.Chmod(filepath.Join(dir, "lib/util.js"), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	pkg2, err := tflambda.BuildSourcePackage(dir, []string{"*.pyc", "node_modules/.cache"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if pkg1.Hash != pkg2.Hash {
+		t.Errorf("expected identical packages, got hashes %s and %s", pkg1.Hash, pkg2.Hash)
+	}
+
+	testWriteSourceFile(t, dir, "index.js", "exports.handler = async () => {};", 0600)
+
+	pkg3, err := tflambda.BuildSourcePackage(dir, []string{"*.pyc", "node_modules/.cache"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if pkg1.Hash == pkg3.Hash {
+		t.Errorf("expected different packages after content change, got hash %s", pkg3.Hash)
+	}
+}
+
+func TestBuildSourcePackage_errors(t *testing.T) {
+	if _, err := tflambda.BuildSourcePackage(t.TempDir(), nil); err == nil {
+		t.Error("expected error for empty directory")
+	}
+
+	if _, err := tflambda.BuildSourcePackage(filepath.Join(t.TempDir(), "missing"), nil); err == nil {
+		t.Error("expected error for missing directory")
+	}
+
+	dir := t.TempDir()
+	testWriteSourceFile(t, dir, "index.js", "exports.handler = () => {};", 0644)
+
+	if _, err := tflambda.BuildSourcePackage(dir, []string{"["}); err == nil {
+		t.Error("expected error for invalid exclude pattern")
+	}
+}
+
+func testWriteSourceFile(t *testing.T, dir, name, content string, mode os.FileMode) {
+	t.Helper()
+
+	p := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := os.WriteFile(p, []byte(content), mode); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := os.Chmod(p, mode); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
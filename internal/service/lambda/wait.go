@@ -1,10 +1,14 @@
 package lambda
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -18,6 +22,8 @@ const (
 	lambdaFunctionExtraThrottlingTimeout = 9 * time.Minute
 
 	eventSourceMappingPropagationTimeout = 5 * time.Minute
+
+	aliasTrafficShiftAlarmPollInterval = 30 * time.Second
 )
 
 func waitEventSourceMappingCreate(conn *lambda.Lambda, id string) (*lambda.EventSourceMappingConfiguration, error) {
@@ -84,3 +90,30 @@ func waitEventSourceMappingUpdate(conn *lambda.Lambda, id string) (*lambda.Event
 
 	return nil, err
 }
+
+// waitAliasTrafficShiftStep waits for the duration of a traffic shifting step, returning an error
+// as soon as any of the alarms fires or if the step can't complete within the timeout.
+func waitAliasTrafficShiftStep(conn *cloudwatch.CloudWatch, alarmNames []*string, interval, timeout time.Duration) error {
+	pollInterval := aliasTrafficShiftAlarmPollInterval
+	if interval < pollInterval {
+		pollInterval = interval
+	}
+
+	stepEnd := time.Now().Add(interval)
+
+	return tfresource.WaitUntil(timeout, func() (bool, error) {
+		if len(alarmNames) > 0 {
+			alarms, err := findAlarmsInAlarmState(conn, alarmNames)
+
+			if err != nil {
+				return false, fmt.Errorf("error reading CloudWatch Alarms: %w", err)
+			}
+
+			if len(alarms) > 0 {
+				return false, fmt.Errorf("CloudWatch Alarm(s) in %s state: %s", cloudwatch.StateValueAlarm, strings.Join(alarms, ", "))
+			}
+		}
+
+		return !time.Now().Before(stepEnd), nil
+	}, tfresource.WaitOpts{PollInterval: pollInterval})
+}
@@ -0,0 +1,70 @@
+package organizations
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceEffectivePolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEffectivePolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"last_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(organizations.EffectivePolicyType_Values(), false),
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+		},
+	}
+}
+
+func dataSourceEffectivePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).OrganizationsConn
+
+	policyType := d.Get("policy_type").(string)
+	targetID := d.Get("target_id").(string)
+
+	policy, err := FindEffectivePolicy(conn, policyType, targetID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Effective Policy (%s): %w", policyType, err)
+	}
+
+	if targetID = aws.StringValue(policy.TargetId); targetID == "" {
+		targetID = meta.(*conns.AWSClient).AccountID
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", targetID, policyType))
+	if policy.LastUpdatedTimestamp != nil {
+		d.Set("last_updated_timestamp", aws.TimeValue(policy.LastUpdatedTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_timestamp", nil)
+	}
+	d.Set("policy_content", policy.PolicyContent)
+	d.Set("policy_type", policy.PolicyType)
+	d.Set("target_id", targetID)
+
+	return nil
+}
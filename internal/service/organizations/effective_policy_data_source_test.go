@@ -0,0 +1,84 @@
+package organizations_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccEffectivePolicyDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_organizations_effective_policy.test"
+	organizationResourceName := "aws_organizations_organization.test"
+	tagPolicyContent := `{ "tags": { "costcenter": { "tag_key": { "@@assign": "CostCenter" }, "tag_value": { "@@assign": [ "100", "200" ] } } } }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck: acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectivePolicyDataSourceConfig_basic(rName, tagPolicyContent),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrRFC3339(dataSourceName, "last_updated_timestamp"),
+					resource.TestMatchResourceAttr(dataSourceName, "policy_content", regexp.MustCompile(`CostCenter`)),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", organizations.EffectivePolicyTypeTagPolicy),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_id", organizationResourceName, "master_account_id"),
+					resource.TestCheckResourceAttr("data.aws_organizations_tag_policy_compliance.compliant", "compliant", "true"),
+					resource.TestCheckResourceAttr("data.aws_organizations_tag_policy_compliance.noncompliant", "compliant", "false"),
+					resource.TestCheckResourceAttr("data.aws_organizations_tag_policy_compliance.noncompliant", "violations.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEffectivePolicyDataSourceConfig_basic(rName, policyContent string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = ["TAG_POLICY"]
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  name    = %[1]q
+  type    = "TAG_POLICY"
+  content = %[2]s
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = aws_organizations_policy.test.id
+  target_id = aws_organizations_organization.test.master_account_id
+}
+
+data "aws_organizations_effective_policy" "test" {
+  depends_on = [aws_organizations_policy_attachment.test]
+
+  policy_type = "TAG_POLICY"
+  target_id   = aws_organizations_organization.test.master_account_id
+}
+
+data "aws_organizations_tag_policy_compliance" "compliant" {
+  policy_content = data.aws_organizations_effective_policy.test.policy_content
+
+  tags = {
+    CostCenter = "100"
+  }
+}
+
+data "aws_organizations_tag_policy_compliance" "noncompliant" {
+  policy_content = data.aws_organizations_effective_policy.test.policy_content
+
+  tags = {
+    costcenter = "300"
+  }
+}
+`, rName, strconv.Quote(policyContent))
+}
@@ -62,3 +62,100 @@ func FindOrganization(conn *organizations.Organizations) (*organizations.Organiz
 
 	return output.Organization, nil
 }
+
+func FindEffectivePolicy(conn *organizations.Organizations, policyType, targetID string) (*organizations.EffectivePolicy, error) {
+	input := &organizations.DescribeEffectivePolicyInput{
+		PolicyType: aws.String(policyType),
+	}
+
+	if targetID != "" {
+		input.TargetId = aws.String(targetID)
+	}
+
+	output, err := conn.DescribeEffectivePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeEffectivePolicyNotFoundException, organizations.ErrCodeTargetNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EffectivePolicy, nil
+}
+
+func FindAccountsForParent(conn *organizations.Organizations, parentID string) ([]*organizations.Account, error) {
+	input := &organizations.ListAccountsForParentInput{
+		ParentId: aws.String(parentID),
+	}
+	var output []*organizations.Account
+
+	err := conn.ListAccountsForParentPages(input, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Accounts {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeParentNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindOrganizationalUnitsForParent(conn *organizations.Organizations, parentID string) ([]*organizations.OrganizationalUnit, error) {
+	input := &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId: aws.String(parentID),
+	}
+	var output []*organizations.OrganizationalUnit
+
+	err := conn.ListOrganizationalUnitsForParentPages(input, func(page *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.OrganizationalUnits {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeParentNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
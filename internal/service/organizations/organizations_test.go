@@ -47,6 +47,9 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"OrganizationalUnit": testAccPolicyAttachment_OrganizationalUnit,
 			"Root":               testAccPolicyAttachment_Root,
 		},
+		"EffectivePolicy": {
+			"DataSource": testAccEffectivePolicyDataSource_basic,
+		},
 		"DelegatedAdministrator": {
 			"basic":      testAccDelegatedAdministrator_basic,
 			"disappears": testAccDelegatedAdministrator_disappears,
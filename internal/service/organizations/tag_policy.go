@@ -0,0 +1,218 @@
+package organizations
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// TagPolicyViolationReasonKeyCase indicates that a tag key differs from the policy's capitalization.
+	TagPolicyViolationReasonKeyCase = "KEY_CASE"
+	// TagPolicyViolationReasonValueNotAllowed indicates that a tag value is not one of the policy's allowed values.
+	TagPolicyViolationReasonValueNotAllowed = "VALUE_NOT_ALLOWED"
+)
+
+// tagPolicyRule is the effective tag policy for a single tag key.
+type tagPolicyRule struct {
+	// Key is the capitalization required by the policy, if any.
+	Key string
+	// Values are the allowed tag values, which may contain "*" wildcards. Any value is allowed if empty.
+	Values []string
+	// EnforcedFor are the resource types, e.g. "ec2:instance" or "ec2:*", for which noncompliant operations are prevented.
+	EnforcedFor []string
+}
+
+type tagPolicyViolation struct {
+	Key           string
+	Value         string
+	Reason        string
+	ExpectedKey   string
+	AllowedValues []string
+	Enforced      bool
+}
+
+// expandTagPolicy parses tag policy content, as returned by DescribeEffectivePolicy, into rules keyed by lower-cased tag key.
+// Inheritance operators other than "@@assign" are not meaningful in effective policies and are ignored.
+func expandTagPolicy(content string) (map[string]tagPolicyRule, error) {
+	var document struct {
+		Tags map[string]map[string]interface{} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("parsing tag policy: %w", err)
+	}
+
+	rules := make(map[string]tagPolicyRule, len(document.Tags))
+
+	for name, tfMap := range document.Tags {
+		var rule tagPolicyRule
+		var err error
+
+		if v, ok := tfMap["tag_key"]; ok {
+			key, ok := tagPolicyAssignedValue(v).(string)
+
+			if !ok {
+				return nil, fmt.Errorf("parsing tag policy: tag_key for %q is not a string", name)
+			}
+
+			rule.Key = key
+		}
+
+		if v, ok := tfMap["tag_value"]; ok {
+			if rule.Values, err = tagPolicyStrings(tagPolicyAssignedValue(v)); err != nil {
+				return nil, fmt.Errorf("parsing tag policy: tag_value for %q: %w", name, err)
+			}
+		}
+
+		if v, ok := tfMap["enforced_for"]; ok {
+			if rule.EnforcedFor, err = tagPolicyStrings(tagPolicyAssignedValue(v)); err != nil {
+				return nil, fmt.Errorf("parsing tag policy: enforced_for for %q: %w", name, err)
+			}
+		}
+
+		rules[strings.ToLower(name)] = rule
+	}
+
+	return rules, nil
+}
+
+// evaluateTagPolicy checks the specified tags against tag policy rules.
+// Tag keys that the policy does not mention are compliant, as are missing keys, matching the behavior of AWS tag policies.
+// If resourceType is not empty, violations are marked as enforced when the rule's enforced_for includes the resource type.
+func evaluateTagPolicy(rules map[string]tagPolicyRule, tags map[string]string, resourceType string) []tagPolicyViolation {
+	var violations []tagPolicyViolation
+
+	for key, value := range tags {
+		rule, ok := rules[strings.ToLower(key)]
+
+		if !ok {
+			continue
+		}
+
+		enforced := resourceType != "" && tagPolicyEnforcedFor(rule.EnforcedFor, resourceType)
+
+		if rule.Key != "" && key != rule.Key {
+			violations = append(violations, tagPolicyViolation{
+				Key:         key,
+				Value:       value,
+				Reason:      TagPolicyViolationReasonKeyCase,
+				ExpectedKey: rule.Key,
+				Enforced:    enforced,
+			})
+		}
+
+		if len(rule.Values) > 0 && !tagPolicyValueAllowed(rule.Values, value) {
+			violations = append(violations, tagPolicyViolation{
+				Key:           key,
+				Value:         value,
+				Reason:        TagPolicyViolationReasonValueNotAllowed,
+				ExpectedKey:   rule.Key,
+				AllowedValues: rule.Values,
+				Enforced:      enforced,
+			})
+		}
+	}
+
+	sort.Slice(violations, func(i, j int) bool {
+		if violations[i].Key != violations[j].Key {
+			return violations[i].Key < violations[j].Key
+		}
+
+		return violations[i].Reason < violations[j].Reason
+	})
+
+	return violations
+}
+
+// tagPolicyAssignedValue returns the value of an "@@assign" operator, or the value itself if it isn't an operator map.
+func tagPolicyAssignedValue(v interface{}) interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		return m["@@assign"]
+	}
+
+	return v
+}
+
+func tagPolicyStrings(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+
+		for _, e := range v {
+			s, ok := e.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("unexpected value (%v), expected a string", e)
+			}
+
+			values = append(values, s)
+		}
+
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unexpected value (%v), expected a string or list of strings", v)
+	}
+}
+
+// tagPolicyValueAllowed returns whether the value matches any of the allowed values.
+// Values are case-sensitive and "*" matches any sequence of characters.
+func tagPolicyValueAllowed(allowed []string, value string) bool {
+	for _, pattern := range allowed {
+		if tagPolicyWildcardMatch(pattern, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func tagPolicyWildcardMatch(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+
+	if len(parts) == 1 {
+		return pattern == value
+	}
+
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+
+	value = value[len(parts[0]):]
+	last := parts[len(parts)-1]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+
+		if i == -1 {
+			return false
+		}
+
+		value = value[i+len(part):]
+	}
+
+	return len(value) >= len(last) && strings.HasSuffix(value, last)
+}
+
+// tagPolicyEnforcedFor returns whether the resource type, e.g. "ec2:instance", is included in enforced_for.
+// Entries of the form "service:*" or "service:ALL_SUPPORTED" include all of a service's resource types.
+func tagPolicyEnforcedFor(enforcedFor []string, resourceType string) bool {
+	service := strings.SplitN(resourceType, ":", 2)[0]
+
+	for _, v := range enforcedFor {
+		if strings.EqualFold(v, resourceType) {
+			return true
+		}
+
+		if parts := strings.SplitN(v, ":", 2); len(parts) == 2 && strings.EqualFold(parts[0], service) && (parts[1] == "*" || parts[1] == "ALL_SUPPORTED") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,141 @@
+package organizations
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourceTagPolicyCompliance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTagPolicyComplianceRead,
+
+		Schema: map[string]*schema.Schema{
+			"compliant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"fail_on_violation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"policy_content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9-]+:[A-Za-z0-9_*-]+$`), "must be in the format service:resource_type, e.g. ec2:instance"),
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"violations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enforced": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"expected_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTagPolicyComplianceRead(d *schema.ResourceData, meta interface{}) error {
+	content := d.Get("policy_content").(string)
+	rules, err := expandTagPolicy(content)
+
+	if err != nil {
+		return err
+	}
+
+	tags := make(map[string]string)
+
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		tags[k] = v.(string)
+	}
+
+	violations := evaluateTagPolicy(rules, tags, d.Get("resource_type").(string))
+
+	if d.Get("fail_on_violation").(bool) && len(violations) > 0 {
+		var messages []string
+
+		for _, v := range violations {
+			messages = append(messages, tagPolicyViolationMessage(v))
+		}
+
+		return fmt.Errorf("tags do not comply with tag policy:\n\t%s", strings.Join(messages, "\n\t"))
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(content)))
+	d.Set("compliant", len(violations) == 0)
+	if err := d.Set("violations", flattenTagPolicyViolations(violations)); err != nil {
+		return fmt.Errorf("error setting violations: %w", err)
+	}
+
+	return nil
+}
+
+func flattenTagPolicyViolations(violations []tagPolicyViolation) []interface{} {
+	tfList := make([]interface{}, 0, len(violations))
+
+	for _, v := range violations {
+		tfList = append(tfList, map[string]interface{}{
+			"allowed_values": v.AllowedValues,
+			"enforced":       v.Enforced,
+			"expected_key":   v.ExpectedKey,
+			"key":            v.Key,
+			"reason":         v.Reason,
+			"value":          v.Value,
+		})
+	}
+
+	return tfList
+}
+
+func tagPolicyViolationMessage(v tagPolicyViolation) string {
+	switch v.Reason {
+	case TagPolicyViolationReasonKeyCase:
+		return fmt.Sprintf("tag key %q must be capitalized as %q", v.Key, v.ExpectedKey)
+	case TagPolicyViolationReasonValueNotAllowed:
+		return fmt.Sprintf("tag %q value %q is not one of the allowed values (%s)", v.Key, v.Value, strings.Join(v.AllowedValues, ", "))
+	default:
+		return fmt.Sprintf("tag %q: %s", v.Key, v.Reason)
+	}
+}
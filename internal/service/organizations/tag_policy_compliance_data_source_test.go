@@ -0,0 +1,88 @@
+package organizations_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+)
+
+const testAccTagPolicyComplianceContent = `{ "tags": { "costcenter": { "tag_key": { "@@assign": "CostCenter" }, "tag_value": { "@@assign": [ "100", "300*" ] }, "enforced_for": { "@@assign": [ "ec2:instance" ] } } } }`
+
+func TestAccOrganizationsTagPolicyComplianceDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_organizations_tag_policy_compliance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagPolicyComplianceDataSourceConfig_basic(testAccTagPolicyComplianceContent, "CostCenter", "300-east"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compliant", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.#", "0"),
+				),
+			},
+			{
+				Config: testAccTagPolicyComplianceDataSourceConfig_basic(testAccTagPolicyComplianceContent, "costcenter", "200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compliant", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.0.key", "costcenter"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.0.reason", tforganizations.TagPolicyViolationReasonKeyCase),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.0.expected_key", "CostCenter"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.0.enforced", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.1.reason", tforganizations.TagPolicyViolationReasonValueNotAllowed),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.1.value", "200"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.1.allowed_values.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsTagPolicyComplianceDataSource_failOnViolation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTagPolicyComplianceDataSourceConfig_failOnViolation(testAccTagPolicyComplianceContent, "CostCenter", "200"),
+				ExpectError: regexp.MustCompile(`tag "CostCenter" value "200" is not one of the allowed values`),
+			},
+		},
+	})
+}
+
+func testAccTagPolicyComplianceDataSourceConfig_basic(policyContent, key, value string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_tag_policy_compliance" "test" {
+  policy_content = %[1]s
+  resource_type  = "ec2:instance"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, strconv.Quote(policyContent), key, value)
+}
+
+func testAccTagPolicyComplianceDataSourceConfig_failOnViolation(policyContent, key, value string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_tag_policy_compliance" "test" {
+  policy_content    = %[1]s
+  fail_on_violation = true
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, strconv.Quote(policyContent), key, value)
+}
@@ -0,0 +1,160 @@
+package organizations
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testTagPolicyContent = `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200", "300*"]},
+      "enforced_for": {"@@assign": ["ec2:instance", "secretsmanager:*"]}
+    },
+    "project": {
+      "tag_key": {"@@assign": "Project"}
+    },
+    "environment": {
+      "tag_value": {"@@assign": "prod"}
+    }
+  }
+}`
+
+func TestExpandTagPolicy(t *testing.T) {
+	rules, err := expandTagPolicy(testTagPolicyContent)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]tagPolicyRule{
+		"costcenter": {
+			Key:         "CostCenter",
+			Values:      []string{"100", "200", "300*"},
+			EnforcedFor: []string{"ec2:instance", "secretsmanager:*"},
+		},
+		"project": {
+			Key: "Project",
+		},
+		"environment": {
+			Values: []string{"prod"},
+		},
+	}
+
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("expected %#v, got %#v", expected, rules)
+	}
+
+	for _, content := range []string{
+		`{`,
+		`{"tags": {"a": {"tag_key": {"@@assign": ["A"]}}}}`,
+		`{"tags": {"a": {"tag_value": {"@@assign": [1]}}}}`,
+	} {
+		if _, err := expandTagPolicy(content); err == nil {
+			t.Errorf("expandTagPolicy(%s): expected error", content)
+		}
+	}
+}
+
+func TestEvaluateTagPolicy(t *testing.T) {
+	rules, err := expandTagPolicy(testTagPolicyContent)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := []struct {
+		Name         string
+		Tags         map[string]string
+		ResourceType string
+		Expected     []tagPolicyViolation
+	}{
+		{
+			Name: "compliant",
+			Tags: map[string]string{"CostCenter": "100", "Project": "x", "environment": "prod", "Other": "any"},
+		},
+		{
+			Name: "wildcard value",
+			Tags: map[string]string{"CostCenter": "300-east"},
+		},
+		{
+			Name: "key case",
+			Tags: map[string]string{"costcenter": "200", "PROJECT": "x"},
+			Expected: []tagPolicyViolation{
+				{Key: "PROJECT", Value: "x", Reason: TagPolicyViolationReasonKeyCase, ExpectedKey: "Project"},
+				{Key: "costcenter", Value: "200", Reason: TagPolicyViolationReasonKeyCase, ExpectedKey: "CostCenter"},
+			},
+		},
+		{
+			Name: "value not allowed",
+			Tags: map[string]string{"Environment": "Prod"},
+			Expected: []tagPolicyViolation{
+				{Key: "Environment", Value: "Prod", Reason: TagPolicyViolationReasonValueNotAllowed, AllowedValues: []string{"prod"}},
+			},
+		},
+		{
+			Name:         "enforced",
+			Tags:         map[string]string{"costCenter": "400"},
+			ResourceType: "ec2:instance",
+			Expected: []tagPolicyViolation{
+				{Key: "costCenter", Value: "400", Reason: TagPolicyViolationReasonKeyCase, ExpectedKey: "CostCenter", Enforced: true},
+				{Key: "costCenter", Value: "400", Reason: TagPolicyViolationReasonValueNotAllowed, ExpectedKey: "CostCenter", AllowedValues: []string{"100", "200", "300*"}, Enforced: true},
+			},
+		},
+		{
+			Name:         "enforced service wildcard",
+			Tags:         map[string]string{"CostCenter": "400"},
+			ResourceType: "secretsmanager:secret",
+			Expected: []tagPolicyViolation{
+				{Key: "CostCenter", Value: "400", Reason: TagPolicyViolationReasonValueNotAllowed, ExpectedKey: "CostCenter", AllowedValues: []string{"100", "200", "300*"}, Enforced: true},
+			},
+		},
+		{
+			Name:         "not enforced",
+			Tags:         map[string]string{"CostCenter": "400"},
+			ResourceType: "ec2:volume",
+			Expected: []tagPolicyViolation{
+				{Key: "CostCenter", Value: "400", Reason: TagPolicyViolationReasonValueNotAllowed, ExpectedKey: "CostCenter", AllowedValues: []string{"100", "200", "300*"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got := evaluateTagPolicy(rules, tc.Tags, tc.ResourceType)
+
+			if !reflect.DeepEqual(got, tc.Expected) {
+				t.Errorf("expected %#v, got %#v", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestTagPolicyWildcardMatch(t *testing.T) {
+	cases := []struct {
+		Pattern  string
+		Value    string
+		Expected bool
+	}{
+		{"abc", "abc", true},
+		{"abc", "ABC", false},
+		{"*", "", true},
+		{"*", "anything", true},
+		{"a*", "abc", true},
+		{"a*", "bac", false},
+		{"*c", "abc", true},
+		{"*c", "abd", false},
+		{"a*c", "abbbc", true},
+		{"a*c", "ac", true},
+		{"a*b*c", "axbyc", true},
+		{"a*b*c", "axyc", false},
+		{"ab*ba", "aba", false},
+	}
+
+	for _, tc := range cases {
+		if got := tagPolicyWildcardMatch(tc.Pattern, tc.Value); got != tc.Expected {
+			t.Errorf("tagPolicyWildcardMatch(%q, %q): expected %t, got %t", tc.Pattern, tc.Value, tc.Expected, got)
+		}
+	}
+}
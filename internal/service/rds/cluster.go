@@ -381,9 +381,43 @@ func ResourceCluster() *schema.Resource {
 			},
 
 			"master_password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_user_password"},
+			},
+
+			"manage_master_user_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"master_password"},
+			},
+
+			"master_user_secret": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"master_user_secret_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
 			},
 
 			"snapshot_identifier": {
@@ -593,6 +627,17 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			requiresModifyDbCluster = true
 		}
 
+		// RestoreDBClusterFromSnapshot does not support ManageMasterUserPassword.
+		if d.Get("manage_master_user_password").(bool) {
+			modifyDbClusterInput.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbClusterInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+
+			requiresModifyDbCluster = true
+		}
+
 		if attr, ok := d.GetOk("option_group_name"); ok {
 			opts.OptionGroupName = aws.String(attr.(string))
 		}
@@ -633,7 +678,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("Error creating RDS Cluster: %s", err)
 		}
 	} else if v, ok := d.GetOk("s3_import"); ok {
-		if _, ok := d.GetOk("master_password"); !ok {
+		if _, ok := d.GetOk("master_password"); !ok && !d.Get("manage_master_user_password").(bool) {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_password": required field is not set`, d.Get("name").(string))
 		}
 		if _, ok := d.GetOk("master_username"); !ok {
@@ -646,7 +691,6 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			DeletionProtection:  aws.Bool(d.Get("deletion_protection").(bool)),
 			Engine:              aws.String(d.Get("engine").(string)),
 			MasterUsername:      aws.String(d.Get("master_username").(string)),
-			MasterUserPassword:  aws.String(d.Get("master_password").(string)),
 			S3BucketName:        aws.String(s3_bucket["bucket_name"].(string)),
 			S3IngestionRoleArn:  aws.String(s3_bucket["ingestion_role"].(string)),
 			S3Prefix:            aws.String(s3_bucket["bucket_prefix"].(string)),
@@ -655,6 +699,16 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			Tags:                Tags(tags.IgnoreAWS()),
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			createOpts.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				createOpts.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		} else {
+			createOpts.MasterUserPassword = aws.String(d.Get("master_password").(string))
+		}
+
 		if v, ok := d.GetOk("backtrack_window"); ok {
 			createOpts.BacktrackWindow = aws.Int64(int64(v.(int)))
 		}
@@ -835,6 +889,17 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
+		// RestoreDBClusterToPointInTime does not support ManageMasterUserPassword.
+		if d.Get("manage_master_user_password").(bool) {
+			modifyDbClusterInput.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbClusterInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+
+			requiresModifyDbCluster = true
+		}
+
 		log.Printf("[DEBUG] RDS Cluster restore options: %s", createOpts)
 
 		resp, err := conn.RestoreDBClusterToPointInTime(createOpts)
@@ -864,6 +929,14 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.MasterUserPassword = aws.String(v.(string))
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			createOpts.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				createOpts.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		}
+
 		if v, ok := d.GetOk("master_username"); ok {
 			createOpts.MasterUsername = aws.String(v.(string))
 		}
@@ -1131,6 +1204,15 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("kms_key_id", dbc.KmsKeyId)
 	d.Set("master_username", dbc.MasterUsername)
+	if dbc.MasterUserSecret != nil {
+		d.Set("manage_master_user_password", true)
+		d.Set("master_user_secret_kms_key_id", dbc.MasterUserSecret.KmsKeyId)
+	} else {
+		d.Set("manage_master_user_password", nil)
+	}
+	if err := d.Set("master_user_secret", flattenManagedMasterUserSecret(dbc.MasterUserSecret)); err != nil {
+		return fmt.Errorf("error setting master_user_secret: %s", err)
+	}
 	d.Set("port", dbc.Port)
 	d.Set("preferred_backup_window", dbc.PreferredBackupWindow)
 	d.Set("preferred_maintenance_window", dbc.PreferredMaintenanceWindow)
@@ -1219,7 +1301,31 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
-	if d.HasChange("master_password") {
+	if d.HasChange("manage_master_user_password") {
+		req.ManageMasterUserPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
+		requestUpdate = true
+
+		if d.Get("manage_master_user_password").(bool) {
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				req.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		} else {
+			// Turning off RDS management of the master user password requires a new password.
+			v, ok := d.GetOk("master_password")
+
+			if !ok {
+				return fmt.Errorf("master_password is required when disabling manage_master_user_password on RDS Cluster (%s)", d.Id())
+			}
+
+			req.MasterUserPassword = aws.String(v.(string))
+		}
+	} else if d.Get("manage_master_user_password").(bool) && d.HasChange("master_user_secret_kms_key_id") {
+		req.ManageMasterUserPassword = aws.Bool(true)
+		req.MasterUserSecretKmsKeyId = aws.String(d.Get("master_user_secret_kms_key_id").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("master_password") && !d.Get("manage_master_user_password").(bool) {
 		req.MasterUserPassword = aws.String(d.Get("master_password").(string))
 		requestUpdate = true
 	}
@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -121,6 +123,21 @@ func ResourceClusterInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"parameter_apply_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_parameter_apply_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"pending_modified_values": pendingModifiedValuesSchema(),
+
+			"reboot_on_pending_parameters": rebootOnPendingParametersSchema(),
+			"reboot_triggers":              rebootTriggersSchema(),
+
 			// apply_immediately is used to determine when the update modifications
 			// take place.
 			// See http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Overview.DBInstance.Modifying.html
@@ -232,7 +249,10 @@ func ResourceClusterInstance() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceClusterInstanceCustomizeDiffRebootOnPendingParameters,
+		),
 	}
 }
 
@@ -483,6 +503,11 @@ func resourceClusterInstanceRead(d *schema.ResourceData, meta interface{}) error
 	if len(db.DBParameterGroups) > 0 {
 		d.Set("db_parameter_group_name", db.DBParameterGroups[0].DBParameterGroupName)
 	}
+	d.Set("parameter_apply_status", parameterApplyStatus(db))
+	d.Set("cluster_parameter_apply_status", clusterParameterApplyStatus(dbc, d.Id()))
+	if err := d.Set("pending_modified_values", flattenPendingModifiedValues(db.PendingModifiedValues)); err != nil {
+		return fmt.Errorf("error setting pending_modified_values: %w", err)
+	}
 
 	tags, err := ListTags(conn, aws.StringValue(db.DBInstanceArn))
 	if err != nil {
@@ -619,6 +644,10 @@ func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 	}
 
+	if err := rebootDBInstanceOnPendingParameters(conn, d.Id(), d.Get("reboot_on_pending_parameters").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -630,6 +659,10 @@ func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 	return resourceClusterInstanceRead(d, meta)
 }
 
+func resourceClusterInstanceCustomizeDiffRebootOnPendingParameters(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return customizeDiffRebootOnPendingParameters(diff, "preferred_maintenance_window", "db_parameter_group_name", "parameter_apply_status", "cluster_parameter_apply_status")
+}
+
 func resourceClusterInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RDSConn
 
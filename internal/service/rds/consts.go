@@ -37,6 +37,35 @@ const (
 	InstanceStatusStorageOptimization           = "storage-optimization"
 )
 
+const (
+	BlueGreenDeploymentStatusAvailable            = "AVAILABLE"
+	BlueGreenDeploymentStatusDeleting             = "DELETING"
+	BlueGreenDeploymentStatusInvalidConfiguration = "INVALID_CONFIGURATION"
+	BlueGreenDeploymentStatusProvisioning         = "PROVISIONING"
+	BlueGreenDeploymentStatusProvisioningFailed   = "PROVISIONING_FAILED"
+	BlueGreenDeploymentStatusSwitchoverCompleted  = "SWITCHOVER_COMPLETED"
+	BlueGreenDeploymentStatusSwitchoverFailed     = "SWITCHOVER_FAILED"
+	BlueGreenDeploymentStatusSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+)
+
+const (
+	ParameterApplyStatusApplying      = "applying"
+	ParameterApplyStatusInSync        = "in-sync"
+	ParameterApplyStatusPendingReboot = "pending-reboot"
+)
+
+const (
+	RebootOnPendingParametersImmediate         = "immediate"
+	RebootOnPendingParametersMaintenanceWindow = "maintenance-window"
+)
+
+func RebootOnPendingParameters_Values() []string {
+	return []string{
+		RebootOnPendingParametersImmediate,
+		RebootOnPendingParametersMaintenanceWindow,
+	}
+}
+
 const (
 	InstanceAutomatedBackupStatusPending     = "pending"
 	InstanceAutomatedBackupStatusReplicating = "replicating"
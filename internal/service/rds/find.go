@@ -306,3 +306,28 @@ func findDBInstanceAutomatedBackups(conn *rds.RDS, input *rds.DescribeDBInstance
 
 	return output, nil
 }
+
+func FindBlueGreenDeploymentByID(conn *rds.RDS, id string) (*rds.BlueGreenDeployment, error) {
+	input := &rds.DescribeBlueGreenDeploymentsInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeBlueGreenDeployments(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.BlueGreenDeployments) == 0 || output.BlueGreenDeployments[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BlueGreenDeployments[0], nil
+}
@@ -255,3 +255,39 @@ func FlattenParameters(list []*rds.Parameter) []map[string]interface{} {
 
 	return result
 }
+
+func flattenManagedMasterUserSecret(apiObject *rds.MasterUserSecret) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"kms_key_id":    aws.StringValue(apiObject.KmsKeyId),
+		"secret_arn":    aws.StringValue(apiObject.SecretArn),
+		"secret_status": aws.StringValue(apiObject.SecretStatus),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPendingModifiedValues(apiObject *rds.PendingModifiedValues) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"allocated_storage":                   aws.Int64Value(apiObject.AllocatedStorage),
+		"backup_retention_period":             aws.Int64Value(apiObject.BackupRetentionPeriod),
+		"ca_cert_identifier":                  aws.StringValue(apiObject.CACertificateIdentifier),
+		"engine_version":                      aws.StringValue(apiObject.EngineVersion),
+		"iam_database_authentication_enabled": aws.BoolValue(apiObject.IAMDatabaseAuthenticationEnabled),
+		"identifier":                          aws.StringValue(apiObject.DBInstanceIdentifier),
+		"instance_class":                      aws.StringValue(apiObject.DBInstanceClass),
+		"iops":                                aws.Int64Value(apiObject.Iops),
+		"multi_az":                            aws.BoolValue(apiObject.MultiAZ),
+		"port":                                aws.Int64Value(apiObject.Port),
+		"storage_type":                        aws.StringValue(apiObject.StorageType),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,8 +10,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -119,6 +122,25 @@ func ResourceInstance() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceADayWindowFormat,
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"switchover_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntBetween(30, 3600),
+						},
+					},
+				},
+			},
 			"ca_cert_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -274,6 +296,36 @@ func ResourceInstance() *schema.Resource {
 				},
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
+			"manage_master_user_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"master_user_secret": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"master_user_secret_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"max_allocated_storage": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -321,16 +373,22 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"parameter_apply_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"parameter_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_user_password"},
 			},
+			"pending_modified_values": pendingModifiedValuesSchema(),
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -357,6 +415,8 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"reboot_on_pending_parameters": rebootOnPendingParametersSchema(),
+			"reboot_triggers":              rebootTriggersSchema(),
 			"replica_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -512,7 +572,10 @@ func ResourceInstance() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceInstanceCustomizeDiffRebootOnPendingParameters,
+		),
 	}
 }
 
@@ -724,7 +787,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		if _, ok := d.GetOk("engine"); !ok {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, dbName)
 		}
-		if _, ok := d.GetOk("password"); !ok {
+		if _, ok := d.GetOk("password"); !ok && !d.Get("manage_master_user_password").(bool) {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, dbName)
 		}
 		if _, ok := d.GetOk("username"); !ok {
@@ -746,7 +809,6 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			S3Prefix:                aws.String(s3_bucket["bucket_prefix"].(string)),
 			S3IngestionRoleArn:      aws.String(s3_bucket["ingestion_role"].(string)),
 			MasterUsername:          aws.String(d.Get("username").(string)),
-			MasterUserPassword:      aws.String(d.Get("password").(string)),
 			PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
 			StorageEncrypted:        aws.Bool(d.Get("storage_encrypted").(bool)),
 			SourceEngine:            aws.String(s3_bucket["source_engine"].(string)),
@@ -754,6 +816,16 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			Tags:                    Tags(tags.IgnoreAWS()),
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			opts.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				opts.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		} else {
+			opts.MasterUserPassword = aws.String(d.Get("password").(string))
+		}
+
 		if attr, ok := d.GetOk("multi_az"); ok {
 			opts.MultiAZ = aws.Bool(attr.(bool))
 		}
@@ -1037,6 +1109,17 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			requiresModifyDbInstance = true
 		}
 
+		// RestoreDBInstanceFromDBSnapshot does not support ManageMasterUserPassword.
+		if d.Get("manage_master_user_password").(bool) {
+			modifyDbInstanceInput.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbInstanceInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+
+			requiresModifyDbInstance = true
+		}
+
 		if attr, ok := d.GetOk("port"); ok {
 			opts.Port = aws.Int64(int64(attr.(int)))
 		}
@@ -1187,6 +1270,17 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 				input.EnableCustomerOwnedIp = aws.Bool(attr.(bool))
 			}
 
+			// RestoreDBInstanceToPointInTime does not support ManageMasterUserPassword.
+			if d.Get("manage_master_user_password").(bool) {
+				modifyDbInstanceInput.ManageMasterUserPassword = aws.Bool(true)
+
+				if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+					modifyDbInstanceInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+				}
+
+				requiresModifyDbInstance = true
+			}
+
 			log.Printf("[DEBUG] DB Instance restore to point in time configuration: %s", input)
 
 			_, err := conn.RestoreDBInstanceToPointInTime(input)
@@ -1206,7 +1300,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		if _, ok := d.GetOk("engine"); !ok {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, dbName)
 		}
-		if _, ok := d.GetOk("password"); !ok {
+		if _, ok := d.GetOk("password"); !ok && !d.Get("manage_master_user_password").(bool) {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, dbName)
 		}
 		if _, ok := d.GetOk("username"); !ok {
@@ -1220,7 +1314,6 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			DBInstanceIdentifier:    aws.String(identifier),
 			DeletionProtection:      aws.Bool(d.Get("deletion_protection").(bool)),
 			MasterUsername:          aws.String(d.Get("username").(string)),
-			MasterUserPassword:      aws.String(d.Get("password").(string)),
 			Engine:                  aws.String(d.Get("engine").(string)),
 			EngineVersion:           aws.String(d.Get("engine_version").(string)),
 			StorageEncrypted:        aws.Bool(d.Get("storage_encrypted").(bool)),
@@ -1230,6 +1323,16 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			CopyTagsToSnapshot:      aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			opts.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				opts.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		} else {
+			opts.MasterUserPassword = aws.String(d.Get("password").(string))
+		}
+
 		attr := d.Get("backup_retention_period")
 		opts.BackupRetentionPeriod = aws.Int64(int64(attr.(int)))
 
@@ -1452,6 +1555,15 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("identifier_prefix", create.NamePrefixFromName(aws.StringValue(v.DBInstanceIdentifier)))
 	d.Set("resource_id", v.DbiResourceId)
 	d.Set("username", v.MasterUsername)
+	if v.MasterUserSecret != nil {
+		d.Set("manage_master_user_password", true)
+		d.Set("master_user_secret_kms_key_id", v.MasterUserSecret.KmsKeyId)
+	} else {
+		d.Set("manage_master_user_password", nil)
+	}
+	if err := d.Set("master_user_secret", flattenManagedMasterUserSecret(v.MasterUserSecret)); err != nil {
+		return fmt.Errorf("error setting master_user_secret: %w", err)
+	}
 	d.Set("deletion_protection", v.DeletionProtection)
 	d.Set("engine", v.Engine)
 	d.Set("allocated_storage", v.AllocatedStorage)
@@ -1487,6 +1599,10 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if len(v.DBParameterGroups) > 0 {
 		d.Set("parameter_group_name", v.DBParameterGroups[0].DBParameterGroupName)
 	}
+	d.Set("parameter_apply_status", parameterApplyStatus(v))
+	if err := d.Set("pending_modified_values", flattenPendingModifiedValues(v.PendingModifiedValues)); err != nil {
+		return fmt.Errorf("error setting pending_modified_values: %w", err)
+	}
 
 	if v.Endpoint != nil {
 		d.Set("port", v.Endpoint.Port)
@@ -1606,6 +1722,10 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceInstanceCustomizeDiffRebootOnPendingParameters(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return customizeDiffRebootOnPendingParameters(diff, "maintenance_window", "parameter_group_name", "parameter_apply_status")
+}
+
 func waitUntilDBInstanceAvailableAfterUpdate(id string, conn *rds.RDS, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    resourceInstanceUpdatePendingStates,
@@ -1631,6 +1751,14 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Println("[INFO] Only settings updating, instance changes will be applied in next maintenance window")
 	}
 
+	blueGreenUpdate := instanceBlueGreenUpdateEnabled(d) && d.HasChanges("engine_version", "instance_class", "parameter_group_name")
+
+	if blueGreenUpdate {
+		if err := instanceBlueGreenUpdate(d, conn); err != nil {
+			return err
+		}
+	}
+
 	requestUpdate := false
 	if d.HasChanges("allocated_storage", "iops") {
 		req.Iops = aws.Int64(int64(d.Get("iops").(int)))
@@ -1662,15 +1790,15 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		req.DeletionProtection = aws.Bool(d.Get("deletion_protection").(bool))
 		requestUpdate = true
 	}
-	if d.HasChange("instance_class") {
+	if d.HasChange("instance_class") && !blueGreenUpdate {
 		req.DBInstanceClass = aws.String(d.Get("instance_class").(string))
 		requestUpdate = true
 	}
-	if d.HasChange("parameter_group_name") {
+	if d.HasChange("parameter_group_name") && !blueGreenUpdate {
 		req.DBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 		requestUpdate = true
 	}
-	if d.HasChange("engine_version") {
+	if d.HasChange("engine_version") && !blueGreenUpdate {
 		req.EngineVersion = aws.String(d.Get("engine_version").(string))
 		req.AllowMajorVersionUpgrade = aws.Bool(d.Get("allow_major_version_upgrade").(bool))
 		requestUpdate = true
@@ -1696,7 +1824,30 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		req.MaxAllocatedStorage = aws.Int64(int64(mas))
 		requestUpdate = true
 	}
-	if d.HasChange("password") {
+	if d.HasChange("manage_master_user_password") {
+		req.ManageMasterUserPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
+		requestUpdate = true
+
+		if d.Get("manage_master_user_password").(bool) {
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				req.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		} else {
+			// Turning off RDS management of the master user password requires a new password.
+			v, ok := d.GetOk("password")
+
+			if !ok {
+				return fmt.Errorf("password is required when disabling manage_master_user_password on DB Instance (%s)", d.Id())
+			}
+
+			req.MasterUserPassword = aws.String(v.(string))
+		}
+	} else if d.Get("manage_master_user_password").(bool) && d.HasChange("master_user_secret_kms_key_id") {
+		req.ManageMasterUserPassword = aws.Bool(true)
+		req.MasterUserSecretKmsKeyId = aws.String(d.Get("master_user_secret_kms_key_id").(string))
+		requestUpdate = true
+	}
+	if d.HasChange("password") && !d.Get("manage_master_user_password").(bool) {
 		req.MasterUserPassword = aws.String(d.Get("password").(string))
 		requestUpdate = true
 	}
@@ -1870,6 +2021,10 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := rebootDBInstanceOnPendingParameters(conn, d.Id(), d.Get("reboot_on_pending_parameters").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -1882,6 +2037,161 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceInstanceRead(d, meta)
 }
 
+func instanceBlueGreenUpdateEnabled(d *schema.ResourceData) bool {
+	v, ok := d.GetOk("blue_green_update")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return false
+	}
+
+	return v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)
+}
+
+// instanceBlueGreenUpdate applies engine version, instance class and parameter group changes
+// by way of an RDS Blue/Green Deployment.
+// On switchover the green DB instance takes over the blue DB instance's identifier and endpoint,
+// so the resource ID does not change. The old (renamed) DB instance is then deleted.
+func instanceBlueGreenUpdate(d *schema.ResourceData, conn *rds.RDS) error {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(resource.PrefixedUniqueId("tf-")),
+		Source:                  aws.String(d.Get("arn").(string)),
+	}
+
+	if d.HasChange("engine_version") {
+		input.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+
+	if d.HasChange("parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+	}
+
+	log.Printf("[DEBUG] Creating RDS Blue/Green Deployment: %s", input)
+	output, err := conn.CreateBlueGreenDeployment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating RDS Blue/Green Deployment for DB Instance (%s): %w", d.Id(), err)
+	}
+
+	deploymentID := aws.StringValue(output.BlueGreenDeployment.BlueGreenDeploymentIdentifier)
+
+	deployment, err := waitBlueGreenDeploymentAvailable(conn, deploymentID, timeout)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) create: %w", deploymentID, err)
+	}
+
+	// The instance class of the green environment cannot be set on creation.
+	if d.HasChange("instance_class") {
+		targetID, err := dbInstanceIDFromARN(aws.StringValue(deployment.Target))
+
+		if err != nil {
+			return err
+		}
+
+		input := &rds.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(true),
+			DBInstanceClass:      aws.String(d.Get("instance_class").(string)),
+			DBInstanceIdentifier: aws.String(targetID),
+		}
+
+		log.Printf("[DEBUG] Modifying RDS Blue/Green Deployment (%s) green DB Instance: %s", deploymentID, input)
+		if _, err := conn.ModifyDBInstance(input); err != nil {
+			return fmt.Errorf("error modifying RDS Blue/Green Deployment (%s) green DB Instance (%s): %w", deploymentID, targetID, err)
+		}
+
+		if err := waitUntilDBInstanceAvailableAfterUpdate(targetID, conn, timeout); err != nil {
+			return fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) green DB Instance (%s) to be available: %w", deploymentID, targetID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Switching over RDS Blue/Green Deployment: %s", deploymentID)
+	_, err = conn.SwitchoverBlueGreenDeployment(&rds.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(deploymentID),
+		SwitchoverTimeout:             aws.Int64(int64(d.Get("blue_green_update.0.switchover_timeout").(int))),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error switching over RDS Blue/Green Deployment (%s): %w", deploymentID, err)
+	}
+
+	deployment, err = waitBlueGreenDeploymentSwitchoverCompleted(conn, deploymentID, timeout)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) switchover: %w", deploymentID, err)
+	}
+
+	// After switchover the deployment's source is the old, renamed DB instance.
+	oldID, err := dbInstanceIDFromARN(aws.StringValue(deployment.Source))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment: %s", deploymentID)
+	_, err = conn.DeleteBlueGreenDeployment(&rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(deploymentID),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return fmt.Errorf("error deleting RDS Blue/Green Deployment (%s): %w", deploymentID, err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(conn, deploymentID, timeout); err != nil {
+		return fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) delete: %w", deploymentID, err)
+	}
+
+	if d.Get("deletion_protection").(bool) {
+		input := &rds.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(true),
+			DBInstanceIdentifier: aws.String(oldID),
+			DeletionProtection:   aws.Bool(false),
+		}
+
+		if _, err := conn.ModifyDBInstance(input); err != nil {
+			return fmt.Errorf("error disabling deletion protection on DB Instance (%s): %w", oldID, err)
+		}
+
+		if err := waitUntilDBInstanceAvailableAfterUpdate(oldID, conn, timeout); err != nil {
+			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %w", oldID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting DB Instance: %s", oldID)
+	_, err = conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(oldID),
+		SkipFinalSnapshot:    aws.Bool(true),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return fmt.Errorf("error deleting DB Instance (%s): %w", oldID, err)
+	}
+
+	if _, err := waitDBInstanceDeleted(conn, oldID, timeout); err != nil {
+		return fmt.Errorf("error waiting for DB Instance (%s) delete: %w", oldID, err)
+	}
+
+	return nil
+}
+
+// dbInstanceIDFromARN returns the DB instance identifier from a DB instance ARN.
+func dbInstanceIDFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing DB Instance ARN (%s): %w", s, err)
+	}
+
+	id := strings.TrimPrefix(v.Resource, "db:")
+
+	if id == v.Resource || id == "" {
+		return "", fmt.Errorf("unexpected format for DB Instance ARN (%s)", s)
+	}
+
+	return id, nil
+}
+
 // resourceInstanceRetrieve fetches DBInstance information from the AWS
 // API. It returns an error if there is a communication problem or unexpected
 // error with AWS. When the DBInstance is not found, it returns no error and a
@@ -771,6 +771,138 @@ func TestAccRDSInstance_password(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_manageMasterUserPassword(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance1, dbInstance2 rds.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_Password(rName, "avoid-plaintext-passwords"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance1),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "false"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "0"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_manageMasterUserPassword(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance2),
+					testAccCheckInstanceNotRecreated(&dbInstance1, &dbInstance2),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_user_secret_kms_key_id", kmsKeyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_user_secret.0.kms_key_id", kmsKeyResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.secret_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.secret_status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_Password(rName, "avoid-plaintext-passwords"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance2),
+					testAccCheckInstanceNotRecreated(&dbInstance1, &dbInstance2),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "false"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_SnapshotIdentifier_manageMasterUserPassword(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance, sourceDbInstance rds.DBInstance
+	var dbSnapshot rds.DBSnapshot
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDbResourceName := "aws_db_instance.source"
+	snapshotResourceName := "aws_db_snapshot.test"
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_SnapshotIdentifier_manageMasterUserPassword(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(sourceDbResourceName, &sourceDbInstance),
+					testAccCheckDbSnapshotExists(snapshotResourceName, &dbSnapshot),
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "true"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.secret_arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_rebootOnPendingParameters(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance rds.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_rebootOnPendingParameters(rName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "reboot_on_pending_parameters", "immediate"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_apply_status", "in-sync"),
+				),
+			},
+			{
+				// The static parameter change changes reboot_triggers, so the same apply reboots the DB instance.
+				Config: testAccInstanceConfig_rebootOnPendingParameters(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "parameter_apply_status", "in-sync"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_ReplicateSourceDB_basic(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -3811,6 +3943,22 @@ func testAccCheckInstanceExists(n string, v *rds.DBInstance) resource.TestCheckF
 	}
 }
 
+// testAccCheckInstanceBlueGreenSwitchedOver verifies that instance2 is the green DB instance
+// of a blue/green switchover from instance1: same identifier, different underlying instance.
+func testAccCheckInstanceBlueGreenSwitchedOver(instance1, instance2 *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(instance1.DBInstanceIdentifier) != aws.StringValue(instance2.DBInstanceIdentifier) {
+			return fmt.Errorf("database instance identifier changed. expected: %s, got: %s", aws.StringValue(instance1.DBInstanceIdentifier), aws.StringValue(instance2.DBInstanceIdentifier))
+		}
+
+		if aws.StringValue(instance1.DbiResourceId) == aws.StringValue(instance2.DbiResourceId) {
+			return fmt.Errorf("database instance was not switched over, resource ID unchanged: %s", aws.StringValue(instance1.DbiResourceId))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckInstanceEC2ClassicDestroy(s *terraform.State) error {
 	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RDSConn
 
@@ -4515,6 +4663,45 @@ func TestAccRDSInstance_license(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_BlueGreenUpdate_parameterGroupName(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance1, dbInstance2 rds.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+	parameterGroupResourceName := "aws_db_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenUpdate_parameterGroupName(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance1),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.switchover_timeout", "600"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenUpdate_parameterGroupName(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance2),
+					testAccCheckInstanceBlueGreenSwitchedOver(&dbInstance1, &dbInstance2),
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "parameter_group_name", parameterGroupResourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
 func testAccInstanceConfig_orderableClass(engine, license, storage, classes string) string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "default" {
@@ -6639,6 +6826,59 @@ resource "aws_db_instance" "test" {
 `, rName, password))
 }
 
+func testAccInstanceConfig_manageMasterUserPassword(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage             = 5
+  apply_immediately             = true
+  engine                        = data.aws_rds_orderable_db_instance.test.engine
+  identifier                    = %[1]q
+  instance_class                = data.aws_rds_orderable_db_instance.test.instance_class
+  manage_master_user_password   = true
+  master_user_secret_kms_key_id = aws_kms_key.test.arn
+  username                      = "tfacctest"
+  skip_final_snapshot           = true
+}
+`, rName))
+}
+
+func testAccInstanceConfig_rebootOnPendingParameters(rName, performanceSchema string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  name   = %[1]q
+  family = data.aws_rds_engine_version.default.parameter_group_family
+
+  parameter {
+    name         = "performance_schema"
+    value        = %[2]q
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage            = 5
+  engine                       = data.aws_rds_orderable_db_instance.test.engine
+  engine_version               = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier                   = %[1]q
+  instance_class               = data.aws_rds_orderable_db_instance.test.instance_class
+  parameter_group_name         = aws_db_parameter_group.test.name
+  password                     = "avoid-plaintext-passwords"
+  reboot_on_pending_parameters = "immediate"
+  username                     = "tfacctest"
+  skip_final_snapshot          = true
+
+  reboot_triggers = {
+    parameters = sha1(jsonencode(aws_db_parameter_group.test.parameter))
+  }
+}
+`, rName, performanceSchema))
+}
+
 func testAccInstanceConfig_ReplicateSourceDB_basic(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -7895,6 +8135,35 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_SnapshotIdentifier_manageMasterUserPassword(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMariadb(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "source" {
+  allocated_storage   = 5
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  identifier          = "%[1]s-source"
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  password            = "avoid-plaintext-passwords"
+  username            = "tfacctest"
+  skip_final_snapshot = true
+}
+
+resource "aws_db_snapshot" "test" {
+  db_instance_identifier = aws_db_instance.source.id
+  db_snapshot_identifier = %[1]q
+}
+
+resource "aws_db_instance" "test" {
+  identifier                  = %[1]q
+  instance_class              = aws_db_instance.source.instance_class
+  manage_master_user_password = true
+  snapshot_identifier         = aws_db_snapshot.test.id
+  skip_final_snapshot         = true
+}
+`, rName))
+}
+
 func testAccInstanceConfig_SnapshotIdentifier_namePrefix(identifierPrefix, sourceName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMariadb(),
@@ -9327,3 +9596,31 @@ resource "aws_db_instance" "test" {
 }
 `, rName))
 }
+
+func testAccInstanceConfig_BlueGreenUpdate_parameterGroupName(rName string, customParameterGroup bool) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  name   = %[1]q
+  family = data.aws_rds_engine_version.default.parameter_group_family
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage       = 10
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier              = %[1]q
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  parameter_group_name    = %[2]t ? aws_db_parameter_group.test.name : "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  password                = "avoid-plaintext-passwords"
+  skip_final_snapshot     = true
+  username                = "tfacctest"
+
+  blue_green_update {
+    enabled            = true
+    switchover_timeout = 600
+  }
+}
+`, rName, customParameterGroup))
+}
@@ -0,0 +1,335 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func pendingModifiedValuesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allocated_storage": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"backup_retention_period": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"ca_cert_identifier": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"engine_version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"iam_database_authentication_enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"identifier": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"instance_class": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"iops": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"multi_az": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"storage_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func rebootOnPendingParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(RebootOnPendingParameters_Values(), false),
+	}
+}
+
+func rebootTriggersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// rebootRequiredForPendingParameters returns whether a DB instance with the specified
+// parameter apply statuses should be rebooted now, given the reboot mode and maintenance window.
+func rebootRequiredForPendingParameters(mode, maintenanceWindow string, now time.Time, statuses ...string) (bool, error) {
+	if mode == "" {
+		return false, nil
+	}
+
+	pending := false
+
+	for _, status := range statuses {
+		if status == ParameterApplyStatusPendingReboot {
+			pending = true
+			break
+		}
+	}
+
+	if !pending {
+		return false, nil
+	}
+
+	if mode == RebootOnPendingParametersMaintenanceWindow {
+		if maintenanceWindow == "" {
+			return false, nil
+		}
+
+		return inMaintenanceWindow(maintenanceWindow, now)
+	}
+
+	return true, nil
+}
+
+// inMaintenanceWindow returns whether the specified time is within the weekly maintenance window.
+// The window is in the format "ddd:hh24:mi-ddd:hh24:mi" (UTC) and may wrap around the end of the week.
+func inMaintenanceWindow(window string, now time.Time) (bool, error) {
+	parts := strings.Split(strings.ToLower(window), "-")
+
+	if len(parts) != 2 {
+		return false, fmt.Errorf("unexpected format for maintenance window (%s), expected ddd:hh24:mi-ddd:hh24:mi", window)
+	}
+
+	start, err := minuteOfWeek(parts[0])
+
+	if err != nil {
+		return false, fmt.Errorf("parsing maintenance window (%s): %w", window, err)
+	}
+
+	end, err := minuteOfWeek(parts[1])
+
+	if err != nil {
+		return false, fmt.Errorf("parsing maintenance window (%s): %w", window, err)
+	}
+
+	now = now.UTC()
+	current := int(now.Weekday())*24*60 + now.Hour()*60 + now.Minute()
+
+	if start <= end {
+		return current >= start && current < end, nil
+	}
+
+	return current >= start || current < end, nil
+}
+
+func minuteOfWeek(s string) (int, error) {
+	parts := strings.Split(s, ":")
+
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("unexpected format (%s), expected ddd:hh24:mi", s)
+	}
+
+	day := -1
+
+	for i, v := range []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"} {
+		if parts[0] == v {
+			day = i
+			break
+		}
+	}
+
+	if day == -1 {
+		return 0, fmt.Errorf("invalid day (%s)", parts[0])
+	}
+
+	hour, err := strconv.Atoi(parts[1])
+
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, fmt.Errorf("invalid hour (%s)", parts[1])
+	}
+
+	minute, err := strconv.Atoi(parts[2])
+
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, fmt.Errorf("invalid minute (%s)", parts[2])
+	}
+
+	return day*24*60 + hour*60 + minute, nil
+}
+
+// customizeDiffRebootOnPendingParameters plans an update of the parameter apply status attributes
+// when the DB instance is waiting for a reboot that reboot_on_pending_parameters allows now.
+// A change to the DB parameter group or to reboot_triggers is assumed to leave the DB instance waiting for a reboot,
+// as the new apply status is not known until the change has been applied.
+func customizeDiffRebootOnPendingParameters(diff *schema.ResourceDiff, maintenanceWindowKey, parameterGroupKey string, statusKeys ...string) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	var statuses []string
+
+	for _, k := range statusKeys {
+		statuses = append(statuses, diff.Get(k).(string))
+	}
+
+	if diff.HasChange(parameterGroupKey) || diff.HasChange("reboot_triggers") {
+		statuses = append(statuses, ParameterApplyStatusPendingReboot)
+	}
+
+	reboot, err := rebootRequiredForPendingParameters(diff.Get("reboot_on_pending_parameters").(string), diff.Get(maintenanceWindowKey).(string), time.Now(), statuses...)
+
+	if err != nil {
+		return err
+	}
+
+	if !reboot {
+		return nil
+	}
+
+	for _, k := range statusKeys {
+		if err := diff.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rebootDBInstanceOnPendingParameters reboots the specified DB instance if it has DB parameter group
+// (or, for Aurora, DB cluster parameter group) changes pending a reboot and the reboot mode allows it now.
+// Reboots of instances in the same cluster are serialized, waiting for availability between them.
+func rebootDBInstanceOnPendingParameters(conn *rds.RDS, id, mode string, timeout time.Duration) error {
+	if mode == "" {
+		return nil
+	}
+
+	v, err := FindDBInstanceByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading DB Instance (%s): %w", id, err)
+	}
+
+	// Serialize reboots across a cluster's instances.
+	// The DB instance is read again after acquiring the lock so that its status reflects any preceding reboot.
+	clusterID := aws.StringValue(v.DBClusterIdentifier)
+
+	if clusterID != "" {
+		key := "rds_cluster_reboot_" + clusterID
+		conns.GlobalMutexKV.Lock(key)
+		defer conns.GlobalMutexKV.Unlock(key)
+
+		v, err = FindDBInstanceByID(conn, id)
+
+		if err != nil {
+			return fmt.Errorf("error reading DB Instance (%s): %w", id, err)
+		}
+	}
+
+	var statuses []string
+
+	// Parameter changes are still being applied shortly after the DB parameter group is modified.
+	err = tfresource.WaitUntil(timeout, func() (bool, error) {
+		statuses = []string{parameterApplyStatus(v)}
+
+		if clusterID != "" {
+			dbc, err := FindDBClusterByID(conn, clusterID)
+
+			if err != nil {
+				return false, fmt.Errorf("error reading RDS Cluster (%s): %w", clusterID, err)
+			}
+
+			statuses = append(statuses, clusterParameterApplyStatus(dbc, id))
+		}
+
+		for _, status := range statuses {
+			if status == ParameterApplyStatusApplying {
+				v, err = FindDBInstanceByID(conn, id)
+
+				if err != nil {
+					return false, fmt.Errorf("error reading DB Instance (%s): %w", id, err)
+				}
+
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}, tfresource.WaitOpts{PollInterval: 10 * time.Second})
+
+	if tfresource.TimedOut(err) {
+		return fmt.Errorf("error waiting for DB Instance (%s) parameter changes to be applied: %w", id, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	reboot, err := rebootRequiredForPendingParameters(mode, aws.StringValue(v.PreferredMaintenanceWindow), time.Now(), statuses...)
+
+	if err != nil {
+		return err
+	}
+
+	if !reboot {
+		return nil
+	}
+
+	input := &rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+	}
+
+	log.Printf("[INFO] Rebooting DB Instance (%s) to apply pending parameter changes", id)
+	if _, err := conn.RebootDBInstance(input); err != nil {
+		return fmt.Errorf("error rebooting DB Instance (%s): %w", id, err)
+	}
+
+	if err := waitUntilDBInstanceAvailableAfterUpdate(id, conn, timeout); err != nil {
+		return fmt.Errorf("error waiting for DB Instance (%s) to be available after reboot: %w", id, err)
+	}
+
+	return nil
+}
+
+func parameterApplyStatus(v *rds.DBInstance) string {
+	if len(v.DBParameterGroups) == 0 {
+		return ""
+	}
+
+	return aws.StringValue(v.DBParameterGroups[0].ParameterApplyStatus)
+}
+
+func clusterParameterApplyStatus(v *rds.DBCluster, id string) string {
+	for _, m := range v.DBClusterMembers {
+		if aws.StringValue(m.DBInstanceIdentifier) == id {
+			return aws.StringValue(m.DBClusterParameterGroupStatus)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,93 @@
+package rds
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInMaintenanceWindow(t *testing.T) {
+	// 2022-06-15 is a Wednesday.
+	wed1030 := time.Date(2022, time.June, 15, 10, 30, 0, 0, time.UTC)
+	sat2330 := time.Date(2022, time.June, 18, 23, 30, 0, 0, time.UTC)
+	sun0030 := time.Date(2022, time.June, 19, 0, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		Window      string
+		Now         time.Time
+		Expected    bool
+		ExpectError bool
+	}{
+		{"wed:10:00-wed:11:00", wed1030, true, false},
+		{"wed:10:30-wed:11:00", wed1030, true, false},
+		{"wed:09:00-wed:10:30", wed1030, false, false},
+		{"Wed:10:00-Wed:11:00", wed1030, true, false},
+		{"tue:23:00-wed:11:00", wed1030, true, false},
+		{"thu:10:00-thu:11:00", wed1030, false, false},
+		{"sat:23:00-sun:01:00", sat2330, true, false},
+		{"sat:23:00-sun:01:00", sun0030, true, false},
+		{"sat:23:00-sun:01:00", wed1030, false, false},
+		{"wed:10:00-wed:11:00", wed1030.In(time.FixedZone("UTC+2", 2*60*60)), true, false},
+		{"", wed1030, false, true},
+		{"wed:10:00", wed1030, false, true},
+		{"xyz:10:00-wed:11:00", wed1030, false, true},
+		{"wed:25:00-wed:11:00", wed1030, false, true},
+		{"wed:10:60-wed:11:00", wed1030, false, true},
+	}
+
+	for _, tc := range cases {
+		got, err := inMaintenanceWindow(tc.Window, tc.Now)
+
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("inMaintenanceWindow(%q, %s): expected error", tc.Window, tc.Now)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("inMaintenanceWindow(%q, %s): unexpected error: %s", tc.Window, tc.Now, err)
+
+			continue
+		}
+
+		if got != tc.Expected {
+			t.Errorf("inMaintenanceWindow(%q, %s): expected %t, got %t", tc.Window, tc.Now, tc.Expected, got)
+		}
+	}
+}
+
+func TestRebootRequiredForPendingParameters(t *testing.T) {
+	wed1030 := time.Date(2022, time.June, 15, 10, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		Name              string
+		Mode              string
+		MaintenanceWindow string
+		Statuses          []string
+		Expected          bool
+	}{
+		{"disabled", "", "wed:10:00-wed:11:00", []string{ParameterApplyStatusPendingReboot}, false},
+		{"immediate in sync", RebootOnPendingParametersImmediate, "", []string{ParameterApplyStatusInSync}, false},
+		{"immediate applying", RebootOnPendingParametersImmediate, "", []string{ParameterApplyStatusApplying}, false},
+		{"immediate pending", RebootOnPendingParametersImmediate, "", []string{ParameterApplyStatusPendingReboot}, true},
+		{"immediate cluster pending", RebootOnPendingParametersImmediate, "", []string{ParameterApplyStatusInSync, ParameterApplyStatusPendingReboot}, true},
+		{"window pending inside", RebootOnPendingParametersMaintenanceWindow, "wed:10:00-wed:11:00", []string{ParameterApplyStatusPendingReboot}, true},
+		{"window pending outside", RebootOnPendingParametersMaintenanceWindow, "thu:10:00-thu:11:00", []string{ParameterApplyStatusPendingReboot}, false},
+		{"window pending unknown", RebootOnPendingParametersMaintenanceWindow, "", []string{ParameterApplyStatusPendingReboot}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := rebootRequiredForPendingParameters(tc.Mode, tc.MaintenanceWindow, wed1030, tc.Statuses...)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != tc.Expected {
+				t.Errorf("expected %t, got %t", tc.Expected, got)
+			}
+		})
+	}
+}
@@ -2,13 +2,16 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -282,3 +285,64 @@ func waitDBInstanceAutomatedBackupDeleted(conn *rds.RDS, dbInstanceID, dbInstanc
 
 	return nil, err
 }
+
+func waitBlueGreenDeploymentAvailable(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{BlueGreenDeploymentStatusProvisioning},
+		Target:     []string{BlueGreenDeploymentStatusAvailable},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBlueGreenDeploymentSwitchoverCompleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			BlueGreenDeploymentStatusAvailable,
+			BlueGreenDeploymentStatusSwitchoverInProgress,
+		},
+		Target:     []string{BlueGreenDeploymentStatusSwitchoverCompleted},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBlueGreenDeploymentDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{BlueGreenDeploymentStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBlueGreenDeployment(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,245 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	cidrCollectionChangeTimeout = 2 * time.Minute
+)
+
+func ResourceCIDRLocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCIDRLocationCreate,
+		ReadWithoutTimeout:   resourceCIDRLocationRead,
+		UpdateWithoutTimeout: resourceCIDRLocationUpdate,
+		DeleteWithoutTimeout: resourceCIDRLocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+			"cidr_collection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 16),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_\-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+		},
+	}
+}
+
+func resourceCIDRLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID := d.Get("cidr_collection_id").(string)
+	name := d.Get("name").(string)
+	id := CIDRLocationCreateResourceID(collectionID, name)
+
+	// Adding CIDR blocks to an existing location would merge them with the location's blocks.
+	_, err := FindCIDRLocationByTwoPartKey(ctx, conn, collectionID, name)
+
+	if err == nil {
+		return diag.Errorf("Route 53 CIDR Location (%s) already exists", id)
+	}
+
+	if !tfresource.NotFound(err) {
+		return diag.Errorf("error reading Route 53 CIDR Location (%s): %s", id, err)
+	}
+
+	change := &route53.CidrCollectionChange{
+		Action:       aws.String(route53.CidrCollectionChangeActionPut),
+		CidrList:     flex.ExpandStringSet(d.Get("cidr_blocks").(*schema.Set)),
+		LocationName: aws.String(name),
+	}
+
+	if err := changeCIDRCollection(ctx, conn, collectionID, []*route53.CidrCollectionChange{change}); err != nil {
+		return diag.Errorf("error creating Route 53 CIDR Location (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceCIDRLocationRead(ctx, d, meta)
+}
+
+func resourceCIDRLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cidrBlocks, err := FindCIDRLocationByTwoPartKey(ctx, conn, collectionID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 CIDR Location %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cidr_blocks", cidrBlocks)
+	d.Set("cidr_collection_id", collectionID)
+	d.Set("name", name)
+
+	return nil
+}
+
+func resourceCIDRLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	o, n := d.GetChange("cidr_blocks")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	add, del := ns.Difference(os), os.Difference(ns)
+
+	// Blocks are added before others are removed so that the location is never empty.
+	var changes []*route53.CidrCollectionChange
+
+	if add.Len() > 0 {
+		changes = append(changes, &route53.CidrCollectionChange{
+			Action:       aws.String(route53.CidrCollectionChangeActionPut),
+			CidrList:     flex.ExpandStringSet(add),
+			LocationName: aws.String(name),
+		})
+	}
+
+	if del.Len() > 0 {
+		changes = append(changes, &route53.CidrCollectionChange{
+			Action:       aws.String(route53.CidrCollectionChangeActionDeleteIfExists),
+			CidrList:     flex.ExpandStringSet(del),
+			LocationName: aws.String(name),
+		})
+	}
+
+	if len(changes) > 0 {
+		if err := changeCIDRCollection(ctx, conn, collectionID, changes); err != nil {
+			return diag.Errorf("error updating Route 53 CIDR Location (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceCIDRLocationRead(ctx, d, meta)
+}
+
+func resourceCIDRLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A location is deleted when its last CIDR block is removed, so remove all of the blocks
+	// currently in the location and not just those known to Terraform.
+	cidrBlocks, err := FindCIDRLocationByTwoPartKey(ctx, conn, collectionID, name)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	change := &route53.CidrCollectionChange{
+		Action:       aws.String(route53.CidrCollectionChangeActionDeleteIfExists),
+		CidrList:     aws.StringSlice(cidrBlocks),
+		LocationName: aws.String(name),
+	}
+
+	log.Printf("[INFO] Deleting Route 53 CIDR Location: %s", d.Id())
+	err = changeCIDRCollection(ctx, conn, collectionID, []*route53.CidrCollectionChange{change})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchCidrCollectionException, route53.ErrCodeNoSuchCidrLocationException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// changeCIDRCollection applies changes to a CIDR collection. Changes are conditional on the
+// collection's current version and are retried if the collection is modified concurrently,
+// e.g., by another aws_route53_cidr_location resource.
+func changeCIDRCollection(ctx context.Context, conn *route53.Route53, collectionID string, changes []*route53.CidrCollectionChange) error {
+	err := resource.RetryContext(ctx, cidrCollectionChangeTimeout, func() *resource.RetryError {
+		collection, err := FindCIDRCollectionByID(ctx, conn, collectionID)
+
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("reading Route 53 CIDR Collection (%s): %w", collectionID, err))
+		}
+
+		input := &route53.ChangeCidrCollectionInput{
+			Changes:           changes,
+			CollectionVersion: collection.Version,
+			Id:                aws.String(collectionID),
+		}
+
+		log.Printf("[DEBUG] Changing Route 53 CIDR Collection: %s", input)
+		_, err = conn.ChangeCidrCollectionWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeCidrCollectionVersionMismatchException, route53.ErrCodeConcurrentModification) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		return fmt.Errorf("timeout while changing Route 53 CIDR Collection (%s)", collectionID)
+	}
+
+	return err
+}
@@ -3,11 +3,13 @@ package route53
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -163,3 +165,211 @@ func FindTrafficPolicyInstanceByID(ctx context.Context, conn *route53.Route53, i
 
 	return output.TrafficPolicyInstance, nil
 }
+
+func FindHostedZoneByID(conn *route53.Route53, id string) (*route53.GetHostedZoneOutput, error) {
+	input := &route53.GetHostedZoneInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetHostedZone(input)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.HostedZone == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindResourceRecordSetsByZoneID(conn *route53.Route53, zoneID string) ([]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	var output []*route53.ResourceRecordSet
+
+	err := conn.ListResourceRecordSetsPages(input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findHostedZone returns the hosted zone with the specified ID or, if id is empty, the hosted zone
+// with the specified name matching the private zone, VPC and tag filters.
+func findHostedZone(conn *route53.Route53, id, name string, privateZone bool, vpcID string, tags tftags.KeyValueTags) (*route53.HostedZone, error) {
+	var nextMarker *string
+
+	var hostedZoneFound *route53.HostedZone
+	// We loop through all hostedzone
+	for allHostedZoneListed := false; !allHostedZoneListed; {
+		req := &route53.ListHostedZonesInput{}
+		if nextMarker != nil {
+			req.Marker = nextMarker
+		}
+		log.Printf("[DEBUG] Reading Route53 Zone: %s", req)
+		resp, err := conn.ListHostedZones(req)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error finding Route 53 Hosted Zone: %w", err)
+		}
+		for _, hostedZone := range resp.HostedZones {
+			hostedZoneId := CleanZoneID(aws.StringValue(hostedZone.Id))
+			if id != "" && hostedZoneId == id {
+				hostedZoneFound = hostedZone
+				break
+				// we check if the name is the same as requested and if private zone field is the same as requested or if there is a vpc_id
+			} else if (TrimTrailingPeriod(aws.StringValue(hostedZone.Name)) == TrimTrailingPeriod(name)) && (aws.BoolValue(hostedZone.Config.PrivateZone) == privateZone || (aws.BoolValue(hostedZone.Config.PrivateZone) && vpcID != "")) {
+				matchingVPC := false
+				if vpcID != "" {
+					reqHostedZone := &route53.GetHostedZoneInput{}
+					reqHostedZone.Id = aws.String(hostedZoneId)
+
+					respHostedZone, errHostedZone := conn.GetHostedZone(reqHostedZone)
+					if errHostedZone != nil {
+						return nil, fmt.Errorf("Error finding Route 53 Hosted Zone: %w", errHostedZone)
+					}
+					// we go through all VPCs
+					for _, vpc := range respHostedZone.VPCs {
+						if aws.StringValue(vpc.VPCId) == vpcID {
+							matchingVPC = true
+							break
+						}
+					}
+				} else {
+					matchingVPC = true
+				}
+				// we check if tags match
+				matchingTags := true
+				if len(tags) > 0 {
+					listTags, err := ListTags(conn, hostedZoneId, route53.TagResourceTypeHostedzone)
+
+					if err != nil {
+						return nil, fmt.Errorf("Error finding Route 53 Hosted Zone: %w", err)
+					}
+					matchingTags = listTags.ContainsAll(tags)
+				}
+
+				if matchingTags && matchingVPC {
+					if hostedZoneFound != nil {
+						return nil, fmt.Errorf("multiple Route53Zone found please use vpc_id option to filter")
+					}
+
+					hostedZoneFound = hostedZone
+				}
+			}
+		}
+		if *resp.IsTruncated {
+			nextMarker = resp.NextMarker
+		} else {
+			allHostedZoneListed = true
+		}
+	}
+	if hostedZoneFound == nil {
+		return nil, fmt.Errorf("no matching Route53Zone found")
+	}
+
+	return hostedZoneFound, nil
+}
+
+func FindCIDRCollectionByID(ctx context.Context, conn *route53.Route53, id string) (*route53.CollectionSummary, error) {
+	input := &route53.ListCidrCollectionsInput{}
+	var output *route53.CollectionSummary
+
+	err := conn.ListCidrCollectionsPagesWithContext(ctx, input, func(page *route53.ListCidrCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrCollections {
+			if aws.StringValue(v.Id) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindCIDRLocationByTwoPartKey(ctx context.Context, conn *route53.Route53, collectionID, locationName string) ([]string, error) {
+	input := &route53.ListCidrBlocksInput{
+		CollectionId: aws.String(collectionID),
+		LocationName: aws.String(locationName),
+	}
+	var output []string
+
+	err := conn.ListCidrBlocksPagesWithContext(ctx, input, func(page *route53.ListCidrBlocksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrBlocks {
+			if v == nil {
+				continue
+			}
+
+			output = append(output, aws.StringValue(v.CidrBlock))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchCidrCollectionException, route53.ErrCodeNoSuchCidrLocationException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -23,3 +23,22 @@ func KeySigningKeyParseResourceID(id string) (string, string, error) {
 
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected hosted-zone-id%[2]sname", id, KeySigningKeyResourceIDSeparator)
 }
+
+const CIDRLocationResourceIDSeparator = ","
+
+func CIDRLocationCreateResourceID(collectionID, locationName string) string {
+	parts := []string{collectionID, locationName}
+	id := strings.Join(parts, CIDRLocationResourceIDSeparator)
+
+	return id
+}
+
+func CIDRLocationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, CIDRLocationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cidr-collection-id%[2]slocation-name", id, CIDRLocationResourceIDSeparator)
+}
@@ -99,6 +99,7 @@ func TestParseRecordId(t *testing.T) {
 		{"ABCDEF_prefix._underscore.example.com_A", "ABCDEF", "prefix._underscore.example.com", "A", ""},
 		{"ABCDEF_prefix._underscore.example.com_A_set", "ABCDEF", "prefix._underscore.example.com", "A", "set"},
 		{"ABCDEF_prefix._underscore.example.com_A_set_underscore", "ABCDEF", "prefix._underscore.example.com", "A", "set_underscore"},
+		{"ABCDEF_www.example.com_CNAME_cidr-location_1", "ABCDEF", "www.example.com", "CNAME", "cidr-location_1"},
 	}
 
 	for _, tc := range cases {
@@ -814,6 +815,45 @@ func TestAccRoute53Record_Latency_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_CIDR_basic(t *testing.T) {
+	var record1, record2 route53.ResourceRecordSet
+	resourceName := "aws_route53_record.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53RecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53CIDRRecord(rName, "cidr-location-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordExists(resourceName, &record1),
+					testAccCheckRoute53RecordExists("aws_route53_record.default", &record2),
+					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cidr_routing_policy.0.collection_id", "aws_route53_cidr_collection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.0.location_name", "cidr-location-1"),
+					resource.TestCheckResourceAttr("aws_route53_record.default", "cidr_routing_policy.0.location_name", "*"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite"},
+			},
+			{
+				Config: testAccRoute53CIDRRecord(rName, "cidr-location-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordExists(resourceName, &record1),
+					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.0.location_name", "cidr-location-2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_typeChange(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.sample"
@@ -1680,6 +1720,60 @@ resource "aws_route53_record" "third_region" {
 `, firstRegion, secondRegion, thirdRegion)
 }
 
+func testAccRoute53CIDRRecord(rName, locationName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_cidr_collection" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_cidr_location" "test1" {
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+  name               = "cidr-location-1"
+  cidr_blocks        = ["200.5.3.0/24", "200.6.3.0/24"]
+}
+
+resource "aws_route53_cidr_location" "test2" {
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+  name               = "cidr-location-2"
+  cidr_blocks        = ["200.7.3.0/24"]
+}
+
+resource "aws_route53_zone" "main" {
+  name = "domain.test"
+}
+
+resource "aws_route53_record" "test" {
+  zone_id        = aws_route53_zone.main.zone_id
+  name           = "www"
+  type           = "CNAME"
+  ttl            = "5"
+  set_identifier = "cidr-location"
+  records        = ["cidr.domain.test"]
+
+  cidr_routing_policy {
+    collection_id = aws_route53_cidr_collection.test.id
+    location_name = %[2]q
+  }
+
+  depends_on = [aws_route53_cidr_location.test1, aws_route53_cidr_location.test2]
+}
+
+resource "aws_route53_record" "default" {
+  zone_id        = aws_route53_zone.main.zone_id
+  name           = "www"
+  type           = "CNAME"
+  ttl            = "5"
+  set_identifier = "cidr-default"
+  records        = ["default.domain.test"]
+
+  cidr_routing_policy {
+    collection_id = aws_route53_cidr_collection.test.id
+    location_name = "*"
+  }
+}
+`, rName, locationName)
+}
+
 const testAccRoute53RecordConfigAliasElb = `
 data "aws_availability_zones" "available" {
   state = "available"
@@ -0,0 +1,820 @@
+package route53
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Limits on the changes in a single ChangeResourceRecordSets request.
+	// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+	changeBatchMaxResourceRecords = 1000
+	changeBatchMaxValueCharacters = 32000
+
+	changeRecordSetsPriorRequestTimeout = 2 * time.Minute
+)
+
+func ResourceRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRecordsCreate,
+		Read:   resourceRecordsRead,
+		Update: resourceRecordsUpdate,
+		Delete: resourceRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceRecordsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"zone_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 32),
+									},
+								},
+							},
+						},
+						"cidr_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"location_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"failover_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(route53.ResourceRecordSetFailover_Values(), false),
+									},
+								},
+							},
+						},
+						"geolocation_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"latency_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"multivalue_answer_routing_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+						"weighted_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"weight": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	zone, err := FindHostedZoneByID(conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	tfList := d.Get("record").(*schema.Set).List()
+	recordSets, err := expandRecordSets(tfList, zoneName)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(zoneID)
+
+	changes := RecordSetChanges(nil, recordSets, d.Get("allow_overwrite").(bool))
+
+	if err := changeRecordSetsInBatches(conn, zoneID, changes, "Managed by Terraform"); err != nil {
+		// Track the record sets created by any earlier batches.
+		if err := refreshRecords(d, conn, zoneID, zoneName, tfList); err != nil {
+			log.Printf("[WARN] error reading Route 53 Records (%s): %s", d.Id(), err)
+		}
+
+		return fmt.Errorf("error creating Route 53 Records (%s): %w", d.Id(), err)
+	}
+
+	return resourceRecordsRead(d, meta)
+}
+
+func resourceRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zone, err := FindHostedZoneByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing Route 53 Records from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", d.Id(), err)
+	}
+
+	if err := refreshRecords(d, conn, d.Id(), aws.StringValue(zone.HostedZone.Name), d.Get("record").(*schema.Set).List()); err != nil {
+		return fmt.Errorf("error reading Route 53 Records (%s): %w", d.Id(), err)
+	}
+
+	d.Set("zone_id", d.Id())
+
+	return nil
+}
+
+func resourceRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zone, err := FindHostedZoneByID(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+
+	if d.HasChange("record") {
+		o, n := d.GetChange("record")
+		oldList, newList := o.(*schema.Set).List(), n.(*schema.Set).List()
+
+		oldRecordSets, err := expandRecordSets(oldList, zoneName)
+
+		if err != nil {
+			return err
+		}
+
+		newRecordSets, err := expandRecordSets(newList, zoneName)
+
+		if err != nil {
+			return err
+		}
+
+		// Deletions must match the record sets in the zone exactly.
+		remote, err := findRecordSetsByKey(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading Route 53 Records (%s): %w", d.Id(), err)
+		}
+
+		for key := range oldRecordSets {
+			if v, ok := remote[key]; ok {
+				oldRecordSets[key] = v
+			} else {
+				delete(oldRecordSets, key)
+			}
+		}
+
+		changes := RecordSetChanges(oldRecordSets, newRecordSets, d.Get("allow_overwrite").(bool))
+
+		if err := changeRecordSetsInBatches(conn, d.Id(), changes, "Managed by Terraform"); err != nil {
+			// Save whichever of the old and new record sets exist after the batches that were applied,
+			// so that record sets created by earlier batches are not created again by the next apply.
+			if err := refreshRecords(d, conn, d.Id(), zoneName, append(oldList, newList...)); err != nil {
+				log.Printf("[WARN] error reading Route 53 Records (%s): %s", d.Id(), err)
+			}
+
+			return fmt.Errorf("error updating Route 53 Records (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceRecordsRead(d, meta)
+}
+
+func resourceRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zone, err := FindHostedZoneByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", d.Id(), err)
+	}
+
+	recordSets, err := expandRecordSets(d.Get("record").(*schema.Set).List(), aws.StringValue(zone.HostedZone.Name))
+
+	if err != nil {
+		return err
+	}
+
+	remote, err := findRecordSetsByKey(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Records (%s): %w", d.Id(), err)
+	}
+
+	for key := range recordSets {
+		if v, ok := remote[key]; ok {
+			recordSets[key] = v
+		} else {
+			delete(recordSets, key)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Route 53 Records (%s): %d record sets", d.Id(), len(recordSets))
+	if err := changeRecordSetsInBatches(conn, d.Id(), RecordSetChanges(recordSets, nil, false), "Deleted by Terraform"); err != nil {
+		return fmt.Errorf("error deleting Route 53 Records (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceRecordsImport imports every record set in the hosted zone except the SOA and NS records at its apex.
+func resourceRecordsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	zoneID := CleanZoneID(d.Id())
+	zone, err := FindHostedZoneByID(conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	zoneName := recordSetName(aws.StringValue(zone.HostedZone.Name))
+	recordSets, err := FindResourceRecordSetsByZoneID(conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Route 53 Records (%s): %w", zoneID, err)
+	}
+
+	var tfList []interface{}
+
+	for _, v := range recordSets {
+		name := recordSetName(aws.StringValue(v.Name))
+
+		if rrType := aws.StringValue(v.Type); name == zoneName && (rrType == route53.RRTypeSoa || rrType == route53.RRTypeNs) {
+			continue
+		}
+
+		tfList = append(tfList, flattenRecordSet(v, name))
+	}
+
+	d.SetId(zoneID)
+	d.Set("record", tfList)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// refreshRecords sets the record attribute to the record sets in the zone with the keys of the
+// elements of tfList. Elements equivalent to the record set in the zone are kept as is.
+func refreshRecords(d *schema.ResourceData, conn *route53.Route53, zoneID, zoneName string, tfList []interface{}) error {
+	remote, err := findRecordSetsByKey(conn, zoneID)
+
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	var records []interface{}
+
+	for _, v := range tfList {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		recordSet, err := expandRecordSet(tfMap, zoneName)
+
+		if err != nil {
+			continue
+		}
+
+		key := recordSetKey(recordSet)
+
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		v, ok := remote[key]
+
+		if !ok {
+			continue
+		}
+
+		if RecordSetsAreEquivalent(recordSet, v) {
+			records = append(records, tfMap)
+		} else {
+			records = append(records, flattenRecordSet(v, tfMap["name"].(string)))
+		}
+	}
+
+	return d.Set("record", records)
+}
+
+func findRecordSetsByKey(conn *route53.Route53, zoneID string) (map[string]*route53.ResourceRecordSet, error) {
+	recordSets, err := FindResourceRecordSetsByZoneID(conn, zoneID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]*route53.ResourceRecordSet, len(recordSets))
+
+	for _, v := range recordSets {
+		output[recordSetKey(v)] = v
+	}
+
+	return output, nil
+}
+
+// RecordSetChanges returns the changes that turn the old record sets into the new ones, keyed by
+// name, type and set identifier. Deletions come first so that a name can change record type.
+func RecordSetChanges(old, new map[string]*route53.ResourceRecordSet, allowOverwrite bool) []*route53.Change {
+	var deletes, upserts, creates []*route53.Change
+
+	createAction := route53.ChangeActionCreate
+
+	if allowOverwrite {
+		createAction = route53.ChangeActionUpsert
+	}
+
+	for _, key := range sortedRecordSetKeys(old) {
+		if _, ok := new[key]; !ok {
+			deletes = append(deletes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: old[key],
+			})
+		}
+	}
+
+	for _, key := range sortedRecordSetKeys(new) {
+		v, ok := old[key]
+
+		if !ok {
+			creates = append(creates, &route53.Change{
+				Action:            aws.String(createAction),
+				ResourceRecordSet: new[key],
+			})
+
+			continue
+		}
+
+		if !RecordSetsAreEquivalent(v, new[key]) {
+			upserts = append(upserts, &route53.Change{
+				Action:            aws.String(route53.ChangeActionUpsert),
+				ResourceRecordSet: new[key],
+			})
+		}
+	}
+
+	return append(append(deletes, upserts...), creates...)
+}
+
+// BatchRecordSetChanges splits changes, in order, into batches that each contain at most maxRecords
+// resource records and maxCharacters characters of record values. UPSERT changes count twice.
+func BatchRecordSetChanges(changes []*route53.Change, maxRecords, maxCharacters int) [][]*route53.Change {
+	var batches [][]*route53.Change
+	var batch []*route53.Change
+	var records, characters int
+
+	for _, change := range changes {
+		changeRecords, changeCharacters := 1, 0
+
+		if recordSet := change.ResourceRecordSet; recordSet != nil && len(recordSet.ResourceRecords) > 0 {
+			changeRecords = len(recordSet.ResourceRecords)
+
+			for _, v := range recordSet.ResourceRecords {
+				changeCharacters += len(aws.StringValue(v.Value))
+			}
+		}
+
+		if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+			changeRecords *= 2
+			changeCharacters *= 2
+		}
+
+		if len(batch) > 0 && (records+changeRecords > maxRecords || characters+changeCharacters > maxCharacters) {
+			batches = append(batches, batch)
+			batch, records, characters = nil, 0, 0
+		}
+
+		batch = append(batch, change)
+		records += changeRecords
+		characters += changeCharacters
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+func changeRecordSetsInBatches(conn *route53.Route53, zoneID string, changes []*route53.Change, comment string) error {
+	batches := BatchRecordSetChanges(changes, changeBatchMaxResourceRecords, changeBatchMaxValueCharacters)
+
+	for i, batch := range batches {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: batch,
+				Comment: aws.String(comment),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		log.Printf("[DEBUG] Changing Route 53 Records (%s): batch %d of %d, %d changes", zoneID, i+1, len(batches), len(batch))
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(changeRecordSetsPriorRequestTimeout, func() (interface{}, error) {
+			return ChangeRecordSet(conn, input)
+		}, route53.ErrCodePriorRequestNotComplete)
+
+		if err != nil {
+			return fmt.Errorf("batch %d of %d: %w", i+1, len(batches), err)
+		}
+
+		changeInfo := outputRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
+
+		if changeInfo == nil {
+			continue
+		}
+
+		if _, err := waitChangeInfoStatusInsync(conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+			return fmt.Errorf("batch %d of %d: waiting for change (%s): %w", i+1, len(batches), aws.StringValue(changeInfo.Id), err)
+		}
+	}
+
+	return nil
+}
+
+func expandRecordSets(tfList []interface{}, zoneName string) (map[string]*route53.ResourceRecordSet, error) {
+	recordSets := make(map[string]*route53.ResourceRecordSet, len(tfList))
+
+	for _, v := range tfList {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		recordSet, err := expandRecordSet(tfMap, zoneName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		key := recordSetKey(recordSet)
+
+		if _, ok := recordSets[key]; ok {
+			return nil, fmt.Errorf("duplicate Route 53 record: %s", strings.TrimSuffix(key, "_"))
+		}
+
+		recordSets[key] = recordSet
+	}
+
+	return recordSets, nil
+}
+
+func expandRecordSet(tfMap map[string]interface{}, zoneName string) (*route53.ResourceRecordSet, error) {
+	name := ExpandRecordName(tfMap["name"].(string), zoneName)
+	rrType := tfMap["type"].(string)
+
+	recordSet := &route53.ResourceRecordSet{
+		Name: aws.String(name),
+		Type: aws.String(rrType),
+	}
+
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		alias := v[0].(map[string]interface{})
+
+		recordSet.AliasTarget = &route53.AliasTarget{
+			DNSName:              aws.String(alias["name"].(string)),
+			EvaluateTargetHealth: aws.Bool(alias["evaluate_target_health"].(bool)),
+			HostedZoneId:         aws.String(alias["zone_id"].(string)),
+		}
+	} else {
+		ttl, _ := tfMap["ttl"].(int)
+		records, _ := tfMap["records"].(*schema.Set)
+
+		if ttl == 0 || records == nil || records.Len() == 0 {
+			return nil, fmt.Errorf("Route 53 record %s (%s): either alias or both ttl and records must be set", name, rrType)
+		}
+
+		recordSet.TTL = aws.Int64(int64(ttl))
+		recordSet.ResourceRecords = expandResourceRecords(records.List(), rrType)
+	}
+
+	if v, ok := tfMap["health_check_id"].(string); ok && v != "" {
+		recordSet.HealthCheckId = aws.String(v)
+	}
+
+	if v, ok := tfMap["set_identifier"].(string); ok && v != "" {
+		recordSet.SetIdentifier = aws.String(v)
+	}
+
+	routingPolicies := 0
+
+	if v, ok := tfMap["cidr_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		routingPolicies++
+		cidr := v[0].(map[string]interface{})
+
+		recordSet.CidrRoutingConfig = &route53.CidrRoutingConfig{
+			CollectionId: aws.String(cidr["collection_id"].(string)),
+			LocationName: aws.String(cidr["location_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["failover_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		routingPolicies++
+		recordSet.Failover = aws.String(v[0].(map[string]interface{})["type"].(string))
+	}
+
+	if v, ok := tfMap["geolocation_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		routingPolicies++
+		geolocation := v[0].(map[string]interface{})
+
+		recordSet.GeoLocation = &route53.GeoLocation{
+			ContinentCode:   nilString(geolocation["continent"].(string)),
+			CountryCode:     nilString(geolocation["country"].(string)),
+			SubdivisionCode: nilString(geolocation["subdivision"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["latency_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		routingPolicies++
+		recordSet.Region = aws.String(v[0].(map[string]interface{})["region"].(string))
+	}
+
+	if v, ok := tfMap["weighted_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		routingPolicies++
+		recordSet.Weight = aws.Int64(int64(v[0].(map[string]interface{})["weight"].(int)))
+	}
+
+	if v, ok := tfMap["multivalue_answer_routing_policy"].(bool); ok && v {
+		routingPolicies++
+		recordSet.MultiValueAnswer = aws.Bool(v)
+	}
+
+	if routingPolicies > 1 {
+		return nil, fmt.Errorf("Route 53 record %s (%s): only one routing policy can be set", name, rrType)
+	}
+
+	if routingPolicies > 0 && recordSet.SetIdentifier == nil {
+		return nil, fmt.Errorf("Route 53 record %s (%s): set_identifier must be set with a routing policy", name, rrType)
+	}
+
+	return recordSet, nil
+}
+
+func flattenRecordSet(apiObject *route53.ResourceRecordSet, name string) map[string]interface{} {
+	rrType := aws.StringValue(apiObject.Type)
+
+	tfMap := map[string]interface{}{
+		"health_check_id":                  aws.StringValue(apiObject.HealthCheckId),
+		"multivalue_answer_routing_policy": aws.BoolValue(apiObject.MultiValueAnswer),
+		"name":                             name,
+		"records":                          flex.FlattenStringSet(aws.StringSlice(FlattenResourceRecords(apiObject.ResourceRecords, rrType))),
+		"set_identifier":                   aws.StringValue(apiObject.SetIdentifier),
+		"ttl":                              int(aws.Int64Value(apiObject.TTL)),
+		"type":                             rrType,
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	if v := apiObject.CidrRoutingConfig; v != nil {
+		tfMap["cidr_routing_policy"] = []interface{}{map[string]interface{}{
+			"collection_id": aws.StringValue(v.CollectionId),
+			"location_name": aws.StringValue(v.LocationName),
+		}}
+	}
+
+	if v := apiObject.Failover; v != nil {
+		tfMap["failover_routing_policy"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.GeoLocation; v != nil {
+		tfMap["geolocation_routing_policy"] = []interface{}{map[string]interface{}{
+			"continent":   aws.StringValue(v.ContinentCode),
+			"country":     aws.StringValue(v.CountryCode),
+			"subdivision": aws.StringValue(v.SubdivisionCode),
+		}}
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["latency_routing_policy"] = []interface{}{map[string]interface{}{
+			"region": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weighted_routing_policy"] = []interface{}{map[string]interface{}{
+			"weight": int(aws.Int64Value(v)),
+		}}
+	}
+
+	return tfMap
+}
+
+// recordSetName returns a record set name in the form used by ExpandRecordName.
+func recordSetName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(CleanRecordName(name)), ".")
+}
+
+// recordSetKey identifies a record set within a hosted zone, in the same form as aws_route53_record IDs.
+func recordSetKey(apiObject *route53.ResourceRecordSet) string {
+	return strings.Join([]string{
+		recordSetName(aws.StringValue(apiObject.Name)),
+		aws.StringValue(apiObject.Type),
+		aws.StringValue(apiObject.SetIdentifier),
+	}, "_")
+}
+
+func sortedRecordSetKeys(recordSets map[string]*route53.ResourceRecordSet) []string {
+	keys := make([]string, 0, len(recordSets))
+
+	for key := range recordSets {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// RecordSetsAreEquivalent determines whether two record sets are the same, ignoring the case and
+// escaping of names and the order of resource record values.
+func RecordSetsAreEquivalent(a, b *route53.ResourceRecordSet) bool {
+	return reflect.DeepEqual(normalizeRecordSet(a), normalizeRecordSet(b))
+}
+
+func normalizeRecordSet(apiObject *route53.ResourceRecordSet) *route53.ResourceRecordSet {
+	output := &route53.ResourceRecordSet{
+		CidrRoutingConfig: apiObject.CidrRoutingConfig,
+		Failover:          apiObject.Failover,
+		GeoLocation:       apiObject.GeoLocation,
+		HealthCheckId:     apiObject.HealthCheckId,
+		MultiValueAnswer:  apiObject.MultiValueAnswer,
+		Name:              aws.String(recordSetName(aws.StringValue(apiObject.Name))),
+		Region:            apiObject.Region,
+		SetIdentifier:     apiObject.SetIdentifier,
+		TTL:               apiObject.TTL,
+		Type:              apiObject.Type,
+		Weight:            apiObject.Weight,
+	}
+
+	if !aws.BoolValue(output.MultiValueAnswer) {
+		output.MultiValueAnswer = nil
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		output.AliasTarget = &route53.AliasTarget{
+			DNSName:              aws.String(NormalizeAliasName(aws.StringValue(v.DNSName))),
+			EvaluateTargetHealth: v.EvaluateTargetHealth,
+			HostedZoneId:         v.HostedZoneId,
+		}
+	}
+
+	var values []string
+
+	for _, v := range apiObject.ResourceRecords {
+		values = append(values, aws.StringValue(v.Value))
+	}
+
+	sort.Strings(values)
+
+	for _, v := range values {
+		output.ResourceRecords = append(output.ResourceRecords, &route53.ResourceRecord{Value: aws.String(v)})
+	}
+
+	return output
+}
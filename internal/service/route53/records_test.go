@@ -0,0 +1,453 @@
+package route53_test
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testRecordSet(name, rrType string, ttl int64, values ...string) *route53.ResourceRecordSet {
+	recordSet := &route53.ResourceRecordSet{
+		Name: aws.String(name),
+		TTL:  aws.Int64(ttl),
+		Type: aws.String(rrType),
+	}
+
+	for _, v := range values {
+		recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{Value: aws.String(v)})
+	}
+
+	return recordSet
+}
+
+func TestRecordSetsAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		A          *route53.ResourceRecordSet
+		B          *route53.ResourceRecordSet
+		Equivalent bool
+	}{
+		{
+			Name:       "name case and trailing period",
+			A:          testRecordSet("WWW.example.com", "A", 300, "192.0.2.1"),
+			B:          testRecordSet("www.example.com.", "A", 300, "192.0.2.1"),
+			Equivalent: true,
+		},
+		{
+			Name:       "escaped name",
+			A:          testRecordSet("*.example.com", "A", 300, "192.0.2.1"),
+			B:          testRecordSet(`\052.example.com.`, "A", 300, "192.0.2.1"),
+			Equivalent: true,
+		},
+		{
+			Name:       "value order",
+			A:          testRecordSet("www.example.com", "A", 300, "192.0.2.1", "192.0.2.2"),
+			B:          testRecordSet("www.example.com", "A", 300, "192.0.2.2", "192.0.2.1"),
+			Equivalent: true,
+		},
+		{
+			Name:       "different TTL",
+			A:          testRecordSet("www.example.com", "A", 300, "192.0.2.1"),
+			B:          testRecordSet("www.example.com", "A", 60, "192.0.2.1"),
+			Equivalent: false,
+		},
+		{
+			Name: "alias name",
+			A: &route53.ResourceRecordSet{
+				Name: aws.String("www.example.com"),
+				Type: aws.String("A"),
+				AliasTarget: &route53.AliasTarget{
+					DNSName:              aws.String("my-elb-123.us-west-2.elb.amazonaws.com"),
+					EvaluateTargetHealth: aws.Bool(true),
+					HostedZoneId:         aws.String("Z1H1FL5HABSF5"),
+				},
+			},
+			B: &route53.ResourceRecordSet{
+				Name: aws.String("www.example.com."),
+				Type: aws.String("A"),
+				AliasTarget: &route53.AliasTarget{
+					DNSName:              aws.String("dualstack.my-elb-123.us-west-2.elb.amazonaws.com."),
+					EvaluateTargetHealth: aws.Bool(true),
+					HostedZoneId:         aws.String("Z1H1FL5HABSF5"),
+				},
+			},
+			Equivalent: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := tfroute53.RecordSetsAreEquivalent(testCase.A, testCase.B); got != testCase.Equivalent {
+				t.Errorf("expected equivalent %t, got %t", testCase.Equivalent, got)
+			}
+		})
+	}
+}
+
+func TestRecordSetChanges(t *testing.T) {
+	old := map[string]*route53.ResourceRecordSet{
+		"a.example.com_A_":     testRecordSet("a.example.com", "A", 300, "192.0.2.1"),
+		"b.example.com_A_":     testRecordSet("b.example.com", "A", 300, "192.0.2.2"),
+		"c.example.com_A_":     testRecordSet("c.example.com", "A", 300, "192.0.2.3"),
+		"d.example.com_CNAME_": testRecordSet("d.example.com", "CNAME", 300, "a.example.com"),
+	}
+	new := map[string]*route53.ResourceRecordSet{
+		"a.example.com_A_":     testRecordSet("a.example.com", "A", 300, "192.0.2.1"),
+		"b.example.com_A_":     testRecordSet("b.example.com", "A", 60, "192.0.2.2"),
+		"d.example.com_A_":     testRecordSet("d.example.com", "A", 300, "192.0.2.4"),
+		"e.example.com_TXT_":   testRecordSet("e.example.com", "TXT", 300, `"hello"`),
+		"c.example.com_CNAME_": testRecordSet("c.example.com", "CNAME", 300, "a.example.com"),
+	}
+
+	var got []string
+
+	for _, change := range tfroute53.RecordSetChanges(old, new, false) {
+		got = append(got, fmt.Sprintf("%s %s %s", aws.StringValue(change.Action), aws.StringValue(change.ResourceRecordSet.Name), aws.StringValue(change.ResourceRecordSet.Type)))
+	}
+
+	expected := []string{
+		"DELETE c.example.com A",
+		"DELETE d.example.com CNAME",
+		"UPSERT b.example.com A",
+		"CREATE c.example.com CNAME",
+		"CREATE d.example.com A",
+		"CREATE e.example.com TXT",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	for _, change := range tfroute53.RecordSetChanges(nil, new, true) {
+		if action := aws.StringValue(change.Action); action != route53.ChangeActionUpsert {
+			t.Errorf("expected UPSERT when overwriting is allowed, got %s", action)
+		}
+	}
+}
+
+func TestBatchRecordSetChanges(t *testing.T) {
+	change := func(action string, values ...string) *route53.Change {
+		return &route53.Change{
+			Action:            aws.String(action),
+			ResourceRecordSet: testRecordSet("www.example.com", "A", 300, values...),
+		}
+	}
+
+	changes := []*route53.Change{
+		change(route53.ChangeActionDelete, "192.0.2.1", "192.0.2.2"),
+		change(route53.ChangeActionCreate, "192.0.2.3"),
+		change(route53.ChangeActionUpsert, "192.0.2.4"),
+		change(route53.ChangeActionCreate, "192.0.2.5", "192.0.2.6", "192.0.2.7", "192.0.2.8", "192.0.2.9"),
+		change(route53.ChangeActionCreate, "192.0.2.10"),
+	}
+
+	var got []int
+
+	for _, batch := range tfroute53.BatchRecordSetChanges(changes, 4, 1000) {
+		got = append(got, len(batch))
+	}
+
+	// The fourth change exceeds the limit on its own and is sent in a batch by itself.
+	if expected := []int{2, 1, 1, 1}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected batch sizes %v, got %v", expected, got)
+	}
+
+	got = nil
+
+	for _, batch := range tfroute53.BatchRecordSetChanges(changes, 1000, 30) {
+		got = append(got, len(batch))
+	}
+
+	if expected := []int{2, 1, 1, 1}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected batch sizes %v, got %v", expected, got)
+	}
+}
+
+func TestAccRoute53Records_basic(t *testing.T) {
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53RecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), "192.0.2.1", 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsExist(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": "www",
+						"type": "A",
+						"ttl":  "300",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "record.*.records.*", "192.0.2.1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": "txt",
+						"type": "TXT",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite", "record"},
+			},
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), "192.0.2.2", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsExist(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": "www",
+						"type": "A",
+						"ttl":  "60",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "record.*.records.*", "192.0.2.2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_batches(t *testing.T) {
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53RecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_many(zoneName.String(), 1500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsExist(resourceName, 1500),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1500"),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_many(zoneName.String(), 1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsExist(resourceName, 1200),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_batchFailure(t *testing.T) {
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53RecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_many(zoneName.String(), 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsExist(resourceName, 10),
+				),
+			},
+			{
+				Config:      testAccRecordsConfig_manyConflict(zoneName.String(), 1500),
+				ExpectError: regexp.MustCompile(`batch 2 of 2`),
+			},
+			{
+				Config: testAccRecordsConfig_many(zoneName.String(), 1500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsExist(resourceName, 1500),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1500"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_disappears(t *testing.T) {
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53RecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), "192.0.2.1", 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53RecordsExist(resourceName, 3),
+					acctest.CheckResourceDisappears(acctest.Provider, tfroute53.ResourceRecords(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckRoute53RecordsExist checks the number of record sets in the zone other than the SOA and NS records at its apex.
+func testAccCheckRoute53RecordsExist(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Records ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+		recordSets, err := tfroute53.FindResourceRecordSetsByZoneID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(recordSets) - 2; got != count {
+			return fmt.Errorf("expected %d Route 53 record sets, got %d", count, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRoute53RecordsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_records" {
+			continue
+		}
+
+		recordSets, err := tfroute53.FindResourceRecordSetsByZoneID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(recordSets) > 2 {
+			return fmt.Errorf("Route 53 Records %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccRecordsConfig_basic(zoneName, address string, ttl int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = %[3]d
+    records = [%[2]q]
+  }
+
+  record {
+    name    = "mail"
+    type    = "MX"
+    ttl     = 300
+    records = ["10 mx1.%[1]s", "20 mx2.%[1]s"]
+  }
+
+  record {
+    name    = "txt"
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName, address, ttl)
+}
+
+func testAccRecordsConfig_many(zoneName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  dynamic "record" {
+    for_each = range(%[2]d)
+
+    content {
+      name    = "host-${record.value}"
+      type    = "A"
+      ttl     = 300
+      records = ["10.0.${floor(record.value / 250)}.${record.value %% 250 + 1}"]
+    }
+  }
+}
+`, zoneName, count)
+}
+
+func testAccRecordsConfig_manyConflict(zoneName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  dynamic "record" {
+    for_each = range(%[2]d)
+
+    content {
+      name    = "host-${record.value}"
+      type    = "A"
+      ttl     = 300
+      records = ["10.0.${floor(record.value / 250)}.${record.value %% 250 + 1}"]
+    }
+  }
+
+  # A CNAME can't share its name with other record sets. Sorted last, it fails the final batch.
+  record {
+    name    = "zzz"
+    type    = "A"
+    ttl     = 300
+    records = ["10.1.0.1"]
+  }
+
+  record {
+    name    = "zzz"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["host-0.%[1]s"]
+  }
+}
+`, zoneName, count)
+}
@@ -20,6 +20,19 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_route53_cidr_collection", &resource.Sweeper{
+		Name: "aws_route53_cidr_collection",
+		F:    sweepCIDRCollections,
+		Dependencies: []string{
+			"aws_route53_cidr_location",
+		},
+	})
+
+	resource.AddTestSweepers("aws_route53_cidr_location", &resource.Sweeper{
+		Name: "aws_route53_cidr_location",
+		F:    sweepCIDRLocations,
+	})
+
 	resource.AddTestSweepers("aws_route53_health_check", &resource.Sweeper{
 		Name: "aws_route53_health_check",
 		F:    sweepHealthChecks,
@@ -62,6 +75,110 @@ func init() {
 	})
 }
 
+func sweepCIDRCollections(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).Route53Conn
+	input := &route53.ListCidrCollectionsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListCidrCollectionsPages(input, func(page *route53.ListCidrCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrCollections {
+			r := ResourceCIDRCollection()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Route 53 CIDR Collection sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 CIDR Collections (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Route 53 CIDR Collections (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepCIDRLocations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).Route53Conn
+	input := &route53.ListCidrCollectionsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListCidrCollectionsPages(input, func(page *route53.ListCidrCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrCollections {
+			collectionID := aws.StringValue(v.Id)
+			input := &route53.ListCidrLocationsInput{
+				CollectionId: aws.String(collectionID),
+			}
+
+			err := conn.ListCidrLocationsPages(input, func(page *route53.ListCidrLocationsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.CidrLocations {
+					r := ResourceCIDRLocation()
+					d := r.Data(nil)
+					d.SetId(CIDRLocationCreateResourceID(collectionID, aws.StringValue(v.LocationName)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Route 53 CIDR Collection (%s) locations (%s): %w", collectionID, region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Route 53 CIDR Location sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route 53 CIDR Collections (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route 53 CIDR Locations (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func sweepHealthChecks(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 
@@ -2,7 +2,6 @@ package route53
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -79,7 +78,7 @@ func dataSourceZoneRead(d *schema.ResourceData, meta interface{}) error {
 	name, nameExists := d.GetOk("name")
 	name = name.(string)
 	id, idExists := d.GetOk("zone_id")
-	vpcId, vpcIdExists := d.GetOk("vpc_id")
+	vpcId := d.Get("vpc_id")
 	tags := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS()
 
 	if nameExists && idExists {
@@ -90,75 +89,10 @@ func dataSourceZoneRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Either name or zone_id must be set")
 	}
 
-	var nextMarker *string
+	hostedZoneFound, err := findHostedZone(conn, id.(string), name.(string), d.Get("private_zone").(bool), vpcId.(string), tags)
 
-	var hostedZoneFound *route53.HostedZone
-	// We loop through all hostedzone
-	for allHostedZoneListed := false; !allHostedZoneListed; {
-		req := &route53.ListHostedZonesInput{}
-		if nextMarker != nil {
-			req.Marker = nextMarker
-		}
-		log.Printf("[DEBUG] Reading Route53 Zone: %s", req)
-		resp, err := conn.ListHostedZones(req)
-
-		if err != nil {
-			return fmt.Errorf("Error finding Route 53 Hosted Zone: %w", err)
-		}
-		for _, hostedZone := range resp.HostedZones {
-			hostedZoneId := CleanZoneID(aws.StringValue(hostedZone.Id))
-			if idExists && hostedZoneId == id.(string) {
-				hostedZoneFound = hostedZone
-				break
-				// we check if the name is the same as requested and if private zone field is the same as requested or if there is a vpc_id
-			} else if (TrimTrailingPeriod(aws.StringValue(hostedZone.Name)) == TrimTrailingPeriod(name)) && (aws.BoolValue(hostedZone.Config.PrivateZone) == d.Get("private_zone").(bool) || (aws.BoolValue(hostedZone.Config.PrivateZone) && vpcIdExists)) {
-				matchingVPC := false
-				if vpcIdExists {
-					reqHostedZone := &route53.GetHostedZoneInput{}
-					reqHostedZone.Id = aws.String(hostedZoneId)
-
-					respHostedZone, errHostedZone := conn.GetHostedZone(reqHostedZone)
-					if errHostedZone != nil {
-						return fmt.Errorf("Error finding Route 53 Hosted Zone: %w", errHostedZone)
-					}
-					// we go through all VPCs
-					for _, vpc := range respHostedZone.VPCs {
-						if aws.StringValue(vpc.VPCId) == vpcId.(string) {
-							matchingVPC = true
-							break
-						}
-					}
-				} else {
-					matchingVPC = true
-				}
-				// we check if tags match
-				matchingTags := true
-				if len(tags) > 0 {
-					listTags, err := ListTags(conn, hostedZoneId, route53.TagResourceTypeHostedzone)
-
-					if err != nil {
-						return fmt.Errorf("Error finding Route 53 Hosted Zone: %w", err)
-					}
-					matchingTags = listTags.ContainsAll(tags)
-				}
-
-				if matchingTags && matchingVPC {
-					if hostedZoneFound != nil {
-						return fmt.Errorf("multiple Route53Zone found please use vpc_id option to filter")
-					}
-
-					hostedZoneFound = hostedZone
-				}
-			}
-		}
-		if *resp.IsTruncated {
-			nextMarker = resp.NextMarker
-		} else {
-			allHostedZoneListed = true
-		}
-	}
-	if hostedZoneFound == nil {
-		return fmt.Errorf("no matching Route53Zone found")
+	if err != nil {
+		return err
 	}
 
 	idHostedZone := CleanZoneID(aws.StringValue(hostedZoneFound.Id))
@@ -0,0 +1,74 @@
+package route53
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceZoneExport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceZoneExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "zone_id"},
+			},
+			"private_zone": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_record_set_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "zone_id"},
+			},
+		},
+	}
+}
+
+func dataSourceZoneExportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	hostedZone, err := findHostedZone(conn, d.Get("zone_id").(string), d.Get("name").(string), d.Get("private_zone").(bool), d.Get("vpc_id").(string), tftags.New(nil))
+
+	if err != nil {
+		return err
+	}
+
+	zoneID := CleanZoneID(aws.StringValue(hostedZone.Id))
+	recordSets, err := FindResourceRecordSetsByZoneID(conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Hosted Zone (%s) records: %w", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+	d.Set("content", RenderZoneFile(aws.StringValue(hostedZone.Name), recordSets))
+	d.Set("name", TrimTrailingPeriod(aws.StringValue(hostedZone.Name)))
+	d.Set("private_zone", hostedZone.Config.PrivateZone)
+	d.Set("resource_record_set_count", len(recordSets))
+	d.Set("zone_id", zoneID)
+
+	return nil
+}
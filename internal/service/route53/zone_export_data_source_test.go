@@ -0,0 +1,58 @@
+package route53_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53ZoneExportDataSource_basic(t *testing.T) {
+	resourceName := "aws_route53_zone.test"
+	dataSourceName := "data.aws_route53_zone_export.test"
+
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneExportDataSourceConfig(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", dataSourceName, "zone_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_set_count", "3"),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexp.MustCompile(`(?m)^\$ORIGIN `)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexp.MustCompile(`(?m)^@\t\d+\tIN\tSOA\t`)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexp.MustCompile(`(?m)^www\t300\tIN\tA\t192\.0\.2\.1$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneExportDataSourceConfig(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+data "aws_route53_zone_export" "test" {
+  zone_id = aws_route53_record.test.zone_id
+}
+`, zoneName)
+}
@@ -0,0 +1,590 @@
+package route53
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// Zone files are described in RFC 1035 section 5, with the $TTL directive from RFC 2308 section 4.
+
+// ZoneFileRecordSet is the set of records in a zone file with the same name and type.
+type ZoneFileRecordSet struct {
+	// Name is fully qualified, in lower case and without a trailing period.
+	Name    string
+	Type    string
+	TTL     int
+	Records []string
+}
+
+type zoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+type zoneFileEntry struct {
+	line       int
+	ownerBlank bool
+	tokens     []zoneFileToken
+}
+
+// ParseZoneFile parses the content of a zone file into record sets, in the order in which they first
+// appear. origin and defaultTTL apply until overridden by $ORIGIN and $TTL directives; a negative
+// defaultTTL means that there is no default TTL.
+// Record values are in the form used by aws_route53_record: domain names are fully qualified and
+// TXT and SPF values omit the outer quotes.
+func ParseZoneFile(content, origin string, defaultTTL int) ([]*ZoneFileRecordSet, error) {
+	entries, err := tokenizeZoneFile(content)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if origin != "" {
+		origin = zoneFileAbsoluteName(origin)
+	}
+
+	var recordSets []*ZoneFileRecordSet
+	recordSetsByKey := make(map[string]*ZoneFileRecordSet)
+	var owner string
+	lastTTL := -1
+
+	if defaultTTL < 0 {
+		defaultTTL = -1
+	}
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		switch directive := strings.ToUpper(tokens[0].text); {
+		case !entry.ownerBlank && directive == "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", entry.line)
+			}
+
+			name, err := zoneFileName(tokens[1].text, origin)
+
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", entry.line, err)
+			}
+
+			origin = name
+
+			continue
+		case !entry.ownerBlank && directive == "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL requires a TTL", entry.line)
+			}
+
+			ttl, err := parseZoneFileTTL(tokens[1].text)
+
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", entry.line, err)
+			}
+
+			defaultTTL = ttl
+
+			continue
+		case !entry.ownerBlank && strings.HasPrefix(directive, "$"):
+			return nil, fmt.Errorf("line %d: unsupported directive %s", entry.line, tokens[0].text)
+		}
+
+		if !entry.ownerBlank {
+			name, err := zoneFileName(tokens[0].text, origin)
+
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", entry.line, err)
+			}
+
+			owner = name
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", entry.line)
+		}
+
+		ttl := -1
+
+		// The TTL and class are both optional and may appear in either order.
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if class := strings.ToUpper(tokens[0].text); class == "IN" {
+				tokens = tokens[1:]
+			} else if class == "CH" || class == "HS" || class == "CS" {
+				return nil, fmt.Errorf("line %d: unsupported class %s", entry.line, tokens[0].text)
+			} else if v, err := parseZoneFileTTL(tokens[0].text); err == nil && ttl == -1 {
+				ttl = v
+				tokens = tokens[1:]
+			}
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: record has no type", entry.line)
+		}
+
+		rrType := strings.ToUpper(tokens[0].text)
+
+		if !validRecordType(rrType) {
+			return nil, fmt.Errorf("line %d: unsupported record type %s", entry.line, tokens[0].text)
+		}
+
+		if ttl == -1 {
+			switch {
+			case defaultTTL != -1:
+				ttl = defaultTTL
+			case lastTTL != -1:
+				ttl = lastTTL
+			default:
+				return nil, fmt.Errorf("line %d: record has no TTL and no default TTL is set", entry.line)
+			}
+		}
+
+		lastTTL = ttl
+
+		value, err := zoneFileRecordValue(rrType, tokens[1:], origin)
+
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s record: %w", entry.line, rrType, err)
+		}
+
+		name := strings.TrimSuffix(owner, ".")
+		key := name + " " + rrType
+		recordSet, ok := recordSetsByKey[key]
+
+		if !ok {
+			recordSet = &ZoneFileRecordSet{
+				Name: name,
+				Type: rrType,
+				TTL:  ttl,
+			}
+			recordSetsByKey[key] = recordSet
+			recordSets = append(recordSets, recordSet)
+		}
+
+		duplicate := false
+
+		for _, v := range recordSet.Records {
+			if v == value {
+				duplicate = true
+				break
+			}
+		}
+
+		if !duplicate {
+			recordSet.Records = append(recordSet.Records, value)
+		}
+	}
+
+	return recordSets, nil
+}
+
+// tokenizeZoneFile splits a zone file into entries, joining lines within parentheses and removing comments.
+func tokenizeZoneFile(content string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	var entry *zoneFileEntry
+	var token strings.Builder
+	var inToken, inQuote, inComment bool
+	depth, line, quoteLine := 0, 1, 0
+	startOfLine := true
+
+	endToken := func() {
+		if inToken {
+			entry.tokens = append(entry.tokens, zoneFileToken{text: token.String(), quoted: inQuote})
+			token.Reset()
+			inToken = false
+		}
+	}
+
+	endEntry := func() {
+		if entry != nil && len(entry.tokens) > 0 {
+			entries = append(entries, *entry)
+		}
+
+		entry = nil
+	}
+
+	runes := []rune(content)
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if entry == nil {
+			entry = &zoneFileEntry{line: line, ownerBlank: startOfLine && (r == ' ' || r == '\t')}
+		}
+
+		startOfLine = false
+
+		switch {
+		case inComment:
+			if r == '\n' {
+				inComment = false
+				i--
+			}
+		case inQuote:
+			switch r {
+			case '\\':
+				token.WriteRune(r)
+
+				if i+1 < len(runes) {
+					i++
+					token.WriteRune(runes[i])
+
+					if runes[i] == '\n' {
+						line++
+					}
+				}
+			case '"':
+				endToken()
+				inQuote = false
+			default:
+				if r == '\n' {
+					line++
+				}
+
+				token.WriteRune(r)
+			}
+		case r == '\\':
+			inToken = true
+			token.WriteRune(r)
+
+			if i+1 < len(runes) {
+				i++
+				token.WriteRune(runes[i])
+			}
+		case r == '"':
+			endToken()
+			inToken, inQuote, quoteLine = true, true, line
+		case r == ';':
+			endToken()
+			inComment = true
+		case r == '(':
+			endToken()
+			depth++
+		case r == ')':
+			endToken()
+
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+
+			depth--
+		case r == '\n':
+			endToken()
+			line++
+			startOfLine = true
+
+			if depth == 0 {
+				endEntry()
+			}
+		case unicode.IsSpace(r):
+			endToken()
+		default:
+			inToken = true
+			token.WriteRune(r)
+		}
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", quoteLine)
+	}
+
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+
+	endToken()
+	endEntry()
+
+	return entries, nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds, optionally in the BIND form with units, e.g. "1h30m".
+func parseZoneFileTTL(s string) (int, error) {
+	if v, err := strconv.Atoi(s); err == nil && v >= 0 {
+		return v, nil
+	}
+
+	units := map[rune]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, digits := 0, ""
+
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsDigit(r) {
+			digits += string(r)
+			continue
+		}
+
+		unit, ok := units[r]
+
+		if !ok || digits == "" {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+
+		v, _ := strconv.Atoi(digits)
+		total += v * unit
+		digits = ""
+	}
+
+	if digits != "" || s == "" {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+
+	return total, nil
+}
+
+func zoneFileAbsoluteName(name string) string {
+	return FQDN(strings.ToLower(name))
+}
+
+// zoneFileName returns the absolute form, with a trailing period, of a possibly relative domain name.
+func zoneFileName(name, origin string) (string, error) {
+	if name == "@" {
+		if origin == "" {
+			return "", fmt.Errorf("@ used without an origin")
+		}
+
+		return origin, nil
+	}
+
+	if strings.HasSuffix(name, ".") {
+		return strings.ToLower(name), nil
+	}
+
+	if origin == "" {
+		return "", fmt.Errorf("relative name %q used without an origin", name)
+	}
+
+	if origin == "." {
+		return strings.ToLower(name) + ".", nil
+	}
+
+	return strings.ToLower(name) + "." + origin, nil
+}
+
+func zoneFileRecordValue(rrType string, tokens []zoneFileToken, origin string) (string, error) {
+	// nameFields returns the tokens, requiring count of them, with the fields at the specified
+	// indexes converted to absolute domain names.
+	nameFields := func(count int, names ...int) ([]string, error) {
+		if len(tokens) != count {
+			return nil, fmt.Errorf("expected %d fields, got %d", count, len(tokens))
+		}
+
+		fields := make([]string, len(tokens))
+
+		for i, token := range tokens {
+			fields[i] = token.text
+		}
+
+		for _, i := range names {
+			name, err := zoneFileName(fields[i], origin)
+
+			if err != nil {
+				return nil, err
+			}
+
+			fields[i] = name
+		}
+
+		return fields, nil
+	}
+
+	quote := func(token zoneFileToken) string {
+		if token.quoted {
+			return `"` + token.text + `"`
+		}
+
+		return `"` + strings.ReplaceAll(token.text, `"`, `\"`) + `"`
+	}
+
+	var fields []string
+	var err error
+
+	switch rrType {
+	case route53.RRTypeA, route53.RRTypeAaaa:
+		if len(tokens) != 1 {
+			return "", fmt.Errorf("expected 1 field, got %d", len(tokens))
+		}
+
+		ip := net.ParseIP(tokens[0].text)
+
+		if ip == nil || (rrType == route53.RRTypeA) != (ip.To4() != nil) {
+			return "", fmt.Errorf("invalid address %q", tokens[0].text)
+		}
+
+		return tokens[0].text, nil
+	case route53.RRTypeCname, route53.RRTypeNs, route53.RRTypePtr:
+		fields, err = nameFields(1, 0)
+	case route53.RRTypeMx:
+		fields, err = nameFields(2, 1)
+	case route53.RRTypeSrv:
+		fields, err = nameFields(4, 3)
+	case route53.RRTypeSoa:
+		fields, err = nameFields(7, 0, 1)
+
+		if err == nil {
+			for i := 2; i < 7; i++ {
+				v, err := parseZoneFileTTL(fields[i])
+
+				if err != nil {
+					return "", err
+				}
+
+				fields[i] = strconv.Itoa(v)
+			}
+		}
+	case route53.RRTypeNaptr:
+		if len(tokens) != 6 {
+			return "", fmt.Errorf("expected 6 fields, got %d", len(tokens))
+		}
+
+		replacement, err := zoneFileName(tokens[5].text, origin)
+
+		if err != nil {
+			return "", err
+		}
+
+		fields = []string{tokens[0].text, tokens[1].text, quote(tokens[2]), quote(tokens[3]), quote(tokens[4]), replacement}
+	case route53.RRTypeCaa:
+		if len(tokens) != 3 {
+			return "", fmt.Errorf("expected 3 fields, got %d", len(tokens))
+		}
+
+		fields = []string{tokens[0].text, tokens[1].text, quote(tokens[2])}
+	case route53.RRTypeDs:
+		if len(tokens) < 4 {
+			return "", fmt.Errorf("expected 4 fields, got %d", len(tokens))
+		}
+
+		// The digest may be split into several fields.
+		var digest strings.Builder
+
+		for _, token := range tokens[3:] {
+			digest.WriteString(token.text)
+		}
+
+		fields = []string{tokens[0].text, tokens[1].text, tokens[2].text, digest.String()}
+	case route53.RRTypeTxt, route53.RRTypeSpf:
+		if len(tokens) == 0 {
+			return "", fmt.Errorf("expected at least 1 field")
+		}
+
+		for _, token := range tokens {
+			fields = append(fields, quote(token))
+		}
+
+		return expandTxtEntry(strings.Join(fields, " ")), nil
+	default:
+		for _, token := range tokens {
+			fields = append(fields, token.text)
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(fields, " "), nil
+}
+
+// RenderZoneFile renders record sets, as returned by ListResourceRecordSets, as a zone file with the specified origin.
+// Alias records have no zone file representation and are rendered as comments, as are the routing
+// policies of record sets with a set identifier.
+func RenderZoneFile(origin string, recordSets []*route53.ResourceRecordSet) string {
+	origin = zoneFileAbsoluteName(origin)
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "$ORIGIN %s\n", origin)
+
+	for _, recordSet := range recordSets {
+		owner := zoneFileRelativeName(FQDN(strings.ToLower(CleanRecordName(aws.StringValue(recordSet.Name)))), origin)
+		rrType := aws.StringValue(recordSet.Type)
+
+		var annotations []string
+
+		if v := recordSet.SetIdentifier; v != nil {
+			annotations = append(annotations, fmt.Sprintf("set_identifier=%s", aws.StringValue(v)))
+		}
+
+		if v := recordSet.Weight; v != nil {
+			annotations = append(annotations, fmt.Sprintf("weight=%d", aws.Int64Value(v)))
+		}
+
+		if v := recordSet.Region; v != nil {
+			annotations = append(annotations, fmt.Sprintf("region=%s", aws.StringValue(v)))
+		}
+
+		if v := recordSet.CidrRoutingConfig; v != nil {
+			annotations = append(annotations, fmt.Sprintf("cidr_collection_id=%s cidr_location=%s", aws.StringValue(v.CollectionId), aws.StringValue(v.LocationName)))
+		}
+
+		if v := recordSet.Failover; v != nil {
+			annotations = append(annotations, fmt.Sprintf("failover=%s", aws.StringValue(v)))
+		}
+
+		if v := recordSet.GeoLocation; v != nil {
+			if v.ContinentCode != nil {
+				annotations = append(annotations, fmt.Sprintf("continent=%s", aws.StringValue(v.ContinentCode)))
+			}
+
+			if v.CountryCode != nil {
+				annotations = append(annotations, fmt.Sprintf("country=%s", aws.StringValue(v.CountryCode)))
+			}
+
+			if v.SubdivisionCode != nil {
+				annotations = append(annotations, fmt.Sprintf("subdivision=%s", aws.StringValue(v.SubdivisionCode)))
+			}
+		}
+
+		if aws.BoolValue(recordSet.MultiValueAnswer) {
+			annotations = append(annotations, "multivalue_answer=true")
+		}
+
+		if v := recordSet.HealthCheckId; v != nil {
+			annotations = append(annotations, fmt.Sprintf("health_check_id=%s", aws.StringValue(v)))
+		}
+
+		if v := recordSet.TrafficPolicyInstanceId; v != nil {
+			annotations = append(annotations, fmt.Sprintf("traffic_policy_instance_id=%s", aws.StringValue(v)))
+		}
+
+		if v := recordSet.AliasTarget; v != nil {
+			annotations = append([]string{
+				fmt.Sprintf("alias=%s", FQDN(NormalizeAliasName(aws.StringValue(v.DNSName)))),
+				fmt.Sprintf("alias_zone_id=%s", aws.StringValue(v.HostedZoneId)),
+				fmt.Sprintf("evaluate_target_health=%t", aws.BoolValue(v.EvaluateTargetHealth)),
+			}, annotations...)
+
+			fmt.Fprintf(&b, "; %s\tIN\t%s\t; %s\n", owner, rrType, strings.Join(annotations, " "))
+
+			continue
+		}
+
+		comment := ""
+
+		if len(annotations) > 0 {
+			comment = "\t; " + strings.Join(annotations, " ")
+		}
+
+		for _, v := range recordSet.ResourceRecords {
+			fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s%s\n", owner, aws.Int64Value(recordSet.TTL), rrType, aws.StringValue(v.Value), comment)
+		}
+	}
+
+	return b.String()
+}
+
+// zoneFileRelativeName returns an absolute domain name relative to origin where possible.
+func zoneFileRelativeName(name, origin string) string {
+	if name == origin {
+		return "@"
+	}
+
+	if origin != "." && strings.HasSuffix(name, "."+origin) {
+		return strings.TrimSuffix(name, "."+origin)
+	}
+
+	return name
+}
@@ -0,0 +1,95 @@
+package route53
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceZoneFileRead,
+
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"record_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZoneFileRead(d *schema.ResourceData, meta interface{}) error {
+	content := d.Get("content").(string)
+	defaultTTL := -1
+
+	if v, ok := d.GetOk("default_ttl"); ok {
+		defaultTTL = v.(int)
+	}
+
+	recordSets, err := ParseZoneFile(content, d.Get("origin").(string), defaultTTL)
+
+	if err != nil {
+		return fmt.Errorf("error parsing zone file: %w", err)
+	}
+
+	tfList := make([]interface{}, 0, len(recordSets))
+
+	for _, v := range recordSets {
+		tfList = append(tfList, map[string]interface{}{
+			"key":     fmt.Sprintf("%s_%s", v.Name, v.Type),
+			"name":    v.Name,
+			"records": v.Records,
+			"ttl":     v.TTL,
+			"type":    v.Type,
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(content)))
+
+	if err := d.Set("record_set", tfList); err != nil {
+		return fmt.Errorf("error setting record_set: %w", err)
+	}
+
+	return nil
+}
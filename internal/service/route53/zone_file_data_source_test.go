@@ -0,0 +1,51 @@
+package route53_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53ZoneFileDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_route53_zone_file.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.0.key", "www.example.com_A"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.0.name", "www.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.0.ttl", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.0.records.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.1.name", "example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.1.type", "MX"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.1.ttl", "60"),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.1.records.0", "10 mail.example.com."),
+					resource.TestCheckResourceAttr(dataSourceName, "record_set.2.type", "TXT"),
+				),
+			},
+		},
+	})
+}
+
+const testAccZoneFileDataSourceConfig = `
+data "aws_route53_zone_file" "test" {
+  origin      = "example.com"
+  default_ttl = 60
+
+  content = <<EOT
+www 300 IN A 192.0.2.1
+        IN A 192.0.2.2
+@       IN MX 10 mail
+@       IN TXT "v=spf1 -all"
+EOT
+}
+`
@@ -0,0 +1,199 @@
+package route53_test
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	content := `
+$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1 hostmaster (
+		2022010101 ; serial
+		1d         ; refresh
+		2h         ; retry
+		4w         ; expire
+		300 )      ; minimum
+	IN	NS	ns1
+	IN	NS	ns2.example.net.
+	IN	MX	10 mail
+	IN	TXT	"v=spf1 include:_spf.example.net -all"
+www	300	IN	A	192.0.2.1
+	IN	300	A	192.0.2.2
+	AAAA	2001:db8::1
+*.app	CNAME	www ; wildcard
+long	TXT	( "first part"
+		  "second part" )
+_sip._tcp	SRV	10 60 5060 sip
+	CAA	0 issue "amazon.com"
+$ORIGIN sub.example.com.
+host	60	A	192.0.2.3
+`
+
+	got, err := tfroute53.ParseZoneFile(content, "", -1)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*tfroute53.ZoneFileRecordSet{
+		{Name: "example.com", Type: "SOA", TTL: 3600, Records: []string{"ns1.example.com. hostmaster.example.com. 2022010101 86400 7200 2419200 300"}},
+		{Name: "example.com", Type: "NS", TTL: 3600, Records: []string{"ns1.example.com.", "ns2.example.net."}},
+		{Name: "example.com", Type: "MX", TTL: 3600, Records: []string{"10 mail.example.com."}},
+		{Name: "example.com", Type: "TXT", TTL: 3600, Records: []string{"v=spf1 include:_spf.example.net -all"}},
+		{Name: "www.example.com", Type: "A", TTL: 300, Records: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "www.example.com", Type: "AAAA", TTL: 3600, Records: []string{"2001:db8::1"}},
+		{Name: "*.app.example.com", Type: "CNAME", TTL: 3600, Records: []string{"www.example.com."}},
+		{Name: "long.example.com", Type: "TXT", TTL: 3600, Records: []string{`first part" "second part`}},
+		{Name: "_sip._tcp.example.com", Type: "SRV", TTL: 3600, Records: []string{"10 60 5060 sip.example.com."}},
+		{Name: "_sip._tcp.example.com", Type: "CAA", TTL: 3600, Records: []string{`0 issue "amazon.com"`}},
+		{Name: "host.sub.example.com", Type: "A", TTL: 60, Records: []string{"192.0.2.3"}},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		for i := range got {
+			t.Logf("got %d: %#v", i, got[i])
+		}
+
+		t.Errorf("unexpected record sets")
+	}
+}
+
+func TestParseZoneFile_originAndDefaultTTL(t *testing.T) {
+	got, err := tfroute53.ParseZoneFile("www A 192.0.2.1\n", "example.org", 120)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*tfroute53.ZoneFileRecordSet{
+		{Name: "www.example.org", Type: "A", TTL: 120, Records: []string{"192.0.2.1"}},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected[0], got)
+	}
+}
+
+func TestParseZoneFile_errors(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Content string
+		Error   string
+	}{
+		{
+			Name:    "no origin",
+			Content: "www 300 A 192.0.2.1\n",
+			Error:   `line 1: relative name "www" used without an origin`,
+		},
+		{
+			Name:    "no TTL",
+			Content: "www.example.com. A 192.0.2.1\n",
+			Error:   "line 1: record has no TTL",
+		},
+		{
+			Name:    "unsupported type",
+			Content: "$TTL 300\nwww.example.com. IN HINFO PC Linux\n",
+			Error:   "line 2: unsupported record type HINFO",
+		},
+		{
+			Name:    "invalid address",
+			Content: "$TTL 300\nwww.example.com. IN A 2001:db8::1\n",
+			Error:   `line 2: A record: invalid address "2001:db8::1"`,
+		},
+		{
+			Name:    "unterminated string",
+			Content: "$TTL 300\nwww.example.com. IN TXT \"hello\n",
+			Error:   "line 2: unterminated quoted string",
+		},
+		{
+			Name:    "unbalanced parentheses",
+			Content: "$TTL 300\nwww.example.com. IN MX ( 10 mail.example.com.\n",
+			Error:   "unbalanced parentheses",
+		},
+		{
+			Name:    "include",
+			Content: "$INCLUDE other.zone\n",
+			Error:   "line 1: unsupported directive \\$INCLUDE",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := tfroute53.ParseZoneFile(testCase.Content, "", -1)
+
+			if err == nil || !regexp.MustCompile(testCase.Error).MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got %v", testCase.Error, err)
+			}
+		})
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	recordSets := []*route53.ResourceRecordSet{
+		{
+			Name:            aws.String("example.com."),
+			Type:            aws.String(route53.RRTypeNs),
+			TTL:             aws.Int64(172800),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("ns-1.awsdns-00.com.")}, {Value: aws.String("ns-2.awsdns-00.net.")}},
+		},
+		{
+			Name:            aws.String(`\052.example.com.`),
+			Type:            aws.String(route53.RRTypeTxt),
+			TTL:             aws.Int64(300),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(`"hello" "world"`)}},
+		},
+		{
+			Name:            aws.String("www.example.com."),
+			Type:            aws.String(route53.RRTypeA),
+			TTL:             aws.Int64(60),
+			SetIdentifier:   aws.String("blue"),
+			Weight:          aws.Int64(10),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+		},
+		{
+			Name: aws.String("app.example.com."),
+			Type: aws.String(route53.RRTypeA),
+			AliasTarget: &route53.AliasTarget{
+				DNSName:              aws.String("dualstack.my-elb-123.us-west-2.elb.amazonaws.com."),
+				EvaluateTargetHealth: aws.Bool(false),
+				HostedZoneId:         aws.String("Z1H1FL5HABSF5"),
+			},
+		},
+		{
+			Name:            aws.String("other.example.net."),
+			Type:            aws.String(route53.RRTypeCname),
+			TTL:             aws.Int64(300),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("example.com")}},
+		},
+	}
+
+	expected := "$ORIGIN example.com.\n" +
+		"@\t172800\tIN\tNS\tns-1.awsdns-00.com.\n" +
+		"@\t172800\tIN\tNS\tns-2.awsdns-00.net.\n" +
+		"*\t300\tIN\tTXT\t\"hello\" \"world\"\n" +
+		"www\t60\tIN\tA\t192.0.2.1\t; set_identifier=blue weight=10\n" +
+		"; app\tIN\tA\t; alias=my-elb-123.us-west-2.elb.amazonaws.com. alias_zone_id=Z1H1FL5HABSF5 evaluate_target_health=false\n" +
+		"other.example.net.\t300\tIN\tCNAME\texample.com\n"
+
+	if got := tfroute53.RenderZoneFile("example.com", recordSets); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	// Rendered zone files can be parsed.
+	parsed, err := tfroute53.ParseZoneFile(expected, "", -1)
+
+	if err != nil {
+		t.Fatalf("unexpected error parsing rendered zone file: %s", err)
+	}
+
+	if len(parsed) != 4 {
+		t.Errorf("expected 4 record sets, got %d", len(parsed))
+	}
+}
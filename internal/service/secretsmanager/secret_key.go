@@ -0,0 +1,446 @@
+package secretsmanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	secretKeyVersionStageCurrent = "AWSCURRENT"
+
+	// Staging label attached to a new version until it has been promoted to AWSCURRENT.
+	secretKeyVersionStagePending = "TFSECRETKEYPENDING"
+
+	secretKeyConflictTimeout = 5 * time.Minute
+)
+
+func ResourceSecretKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecretKeyCreate,
+		Read:   resourceSecretKeyRead,
+		Update: resourceSecretKeyUpdate,
+		Delete: resourceSecretKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_json"},
+			},
+			"value_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ExactlyOneOf:     []string{"value", "value_json"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecretKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	secretID := d.Get("secret_id").(string)
+	key := d.Get("key").(string)
+	id := SecretKeyCreateResourceID(secretID, key)
+
+	value, err := expandSecretKeyValue(d)
+
+	if err != nil {
+		return err
+	}
+
+	versionID, err := modifySecretKey(conn, secretID, key, value)
+
+	if err != nil {
+		return fmt.Errorf("error creating Secrets Manager Secret Key (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("version_id", versionID)
+
+	return resourceSecretKeyRead(d, meta)
+}
+
+func resourceSecretKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	secretID, key, err := SecretKeyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	var output *secretsmanager.GetSecretValueOutput
+
+	err = resource.Retry(PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		output, err = findCurrentSecretValue(conn, secretID)
+
+		if d.IsNewResource() && tfresource.NotFound(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = findCurrentSecretValue(conn, secretID)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Secrets Manager Secret Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secret Key (%s): %w", d.Id(), err)
+	}
+
+	document, err := decodeSecretKeyDocument(aws.StringValue(output.SecretString))
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secret Key (%s): %w", d.Id(), err)
+	}
+
+	raw, ok := document[key]
+
+	if !ok {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Secrets Manager Secret Key (%s): key not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Secrets Manager Secret Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("key", key)
+	d.Set("secret_id", secretID)
+
+	var s string
+
+	if err := json.Unmarshal(raw, &s); err == nil {
+		d.Set("value", s)
+		d.Set("value_json", nil)
+	} else {
+		d.Set("value", nil)
+		d.Set("value_json", string(raw))
+	}
+
+	// Only track the version that last changed this key, not unrelated writes to other keys.
+	if d.Get("version_id").(string) == "" {
+		d.Set("version_id", output.VersionId)
+	}
+
+	return nil
+}
+
+func resourceSecretKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	secretID, key, err := SecretKeyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	value, err := expandSecretKeyValue(d)
+
+	if err != nil {
+		return err
+	}
+
+	versionID, err := modifySecretKey(conn, secretID, key, value)
+
+	if err != nil {
+		return fmt.Errorf("error updating Secrets Manager Secret Key (%s): %w", d.Id(), err)
+	}
+
+	d.Set("version_id", versionID)
+
+	return resourceSecretKeyRead(d, meta)
+}
+
+func resourceSecretKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	secretID, key, err := SecretKeyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Secrets Manager Secret Key: %s", d.Id())
+	_, err = modifySecretKey(conn, secretID, key, nil)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Secrets Manager Secret Key (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const secretKeyResourceIDSeparator = "|"
+
+func SecretKeyCreateResourceID(secretID, key string) string {
+	parts := []string{secretID, key}
+	id := strings.Join(parts, secretKeyResourceIDSeparator)
+
+	return id
+}
+
+func SecretKeyParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, secretKeyResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SECRET_ID%[2]sKEY", id, secretKeyResourceIDSeparator)
+}
+
+func expandSecretKeyValue(d *schema.ResourceData) (json.RawMessage, error) {
+	if v, ok := d.GetOk("value_json"); ok {
+		var buf bytes.Buffer
+
+		if err := json.Compact(&buf, []byte(v.(string))); err != nil {
+			return nil, fmt.Errorf("error parsing value_json: %w", err)
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	return json.Marshal(d.Get("value").(string))
+}
+
+func decodeSecretKeyDocument(secretString string) (map[string]json.RawMessage, error) {
+	document := make(map[string]json.RawMessage)
+
+	if strings.TrimSpace(secretString) == "" {
+		return document, nil
+	}
+
+	if err := json.Unmarshal([]byte(secretString), &document); err != nil {
+		return nil, fmt.Errorf("secret string is not a JSON object: %w", err)
+	}
+
+	return document, nil
+}
+
+// SecretKeyMergeDocument sets key to value in the JSON object secretString,
+// or removes it if value is nil. It reports whether the document changed.
+func SecretKeyMergeDocument(secretString, key string, value json.RawMessage) (string, bool, error) {
+	document, err := decodeSecretKeyDocument(secretString)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	old, exists := document[key]
+
+	if value == nil {
+		if !exists {
+			return secretString, false, nil
+		}
+
+		delete(document, key)
+	} else {
+		if exists && bytes.Equal(old, value) {
+			return secretString, false, nil
+		}
+
+		document[key] = value
+	}
+
+	output, err := json.Marshal(document)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	return string(output), true, nil
+}
+
+var errSecretKeyConflict = errors.New("secret was modified concurrently")
+
+// modifySecretKey performs a read-modify-write of a single key in the current version
+// of a JSON secret. The new version is first staged under a pending label and then
+// promoted to AWSCURRENT only if AWSCURRENT is still attached to the version that was
+// read, so concurrent writers retry rather than overwrite each other's keys.
+func modifySecretKey(conn *secretsmanager.SecretsManager, secretID, key string, value json.RawMessage) (string, error) {
+	// Serialize writers within this process; writers in other processes are handled by the compare-and-swap.
+	conns.GlobalMutexKV.Lock(secretID)
+	defer conns.GlobalMutexKV.Unlock(secretID)
+
+	outputRaw, err := tfresource.RetryWhen(secretKeyConflictTimeout,
+		func() (interface{}, error) {
+			return putSecretKey(conn, secretID, key, value)
+		},
+		func(err error) (bool, error) {
+			if errors.Is(err, errSecretKeyConflict) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return "", err
+	}
+
+	return outputRaw.(string), nil
+}
+
+func putSecretKey(conn *secretsmanager.SecretsManager, secretID, key string, value json.RawMessage) (string, error) {
+	current, err := findCurrentSecretValue(conn, secretID)
+
+	var currentVersionID, currentString string
+
+	switch {
+	case tfresource.NotFound(err) && value != nil:
+		// The secret has no value yet; there is nothing to compare against.
+	case err != nil:
+		return "", err
+	default:
+		currentVersionID = aws.StringValue(current.VersionId)
+		currentString = aws.StringValue(current.SecretString)
+	}
+
+	secretString, changed, err := SecretKeyMergeDocument(currentString, key, value)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !changed {
+		return currentVersionID, nil
+	}
+
+	input := &secretsmanager.PutSecretValueInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		SecretId:           aws.String(secretID),
+		SecretString:       aws.String(secretString),
+	}
+
+	if currentVersionID != "" {
+		input.VersionStages = aws.StringSlice([]string{secretKeyVersionStagePending})
+	}
+
+	log.Printf("[DEBUG] Putting Secrets Manager Secret %q value", secretID)
+	output, err := conn.PutSecretValue(input)
+
+	if err != nil {
+		return "", fmt.Errorf("error putting Secrets Manager Secret value: %w", err)
+	}
+
+	versionID := aws.StringValue(output.VersionId)
+
+	if currentVersionID == "" {
+		return versionID, nil
+	}
+
+	_, err = conn.UpdateSecretVersionStage(&secretsmanager.UpdateSecretVersionStageInput{
+		MoveToVersionId:     aws.String(versionID),
+		RemoveFromVersionId: aws.String(currentVersionID),
+		SecretId:            aws.String(secretID),
+		VersionStage:        aws.String(secretKeyVersionStageCurrent),
+	})
+
+	if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeInvalidParameterException) {
+		if latest, findErr := findCurrentSecretValue(conn, secretID); findErr == nil && aws.StringValue(latest.VersionId) != currentVersionID {
+			log.Printf("[WARN] Secrets Manager Secret (%s) AWSCURRENT moved from %s to %s, retrying", secretID, currentVersionID, aws.StringValue(latest.VersionId))
+			return "", errSecretKeyConflict
+		}
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("error promoting Secrets Manager Secret version (%s) to %s: %w", versionID, secretKeyVersionStageCurrent, err)
+	}
+
+	// Another writer may already have claimed the pending label.
+	_, err = conn.UpdateSecretVersionStage(&secretsmanager.UpdateSecretVersionStageInput{
+		RemoveFromVersionId: aws.String(versionID),
+		SecretId:            aws.String(secretID),
+		VersionStage:        aws.String(secretKeyVersionStagePending),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeInvalidParameterException) {
+		return "", fmt.Errorf("error removing staging label %s from Secrets Manager Secret version (%s): %w", secretKeyVersionStagePending, versionID, err)
+	}
+
+	return versionID, nil
+}
+
+func findCurrentSecretValue(conn *secretsmanager.SecretsManager, secretID string) (*secretsmanager.GetSecretValueOutput, error) {
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(secretID),
+		VersionStage: aws.String(secretKeyVersionStageCurrent),
+	}
+
+	output, err := conn.GetSecretValue(input)
+
+	if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, secretsmanager.ErrCodeInvalidRequestException, "You can’t perform this operation on the secret because it was deleted") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,304 @@
+package secretsmanager_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+)
+
+func TestSecretKeyMergeDocument(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		SecretString    string
+		Key             string
+		Value           json.RawMessage
+		Expected        string
+		ExpectedChanged bool
+		ExpectedError   bool
+	}{
+		{
+			Name:            "empty secret",
+			SecretString:    "",
+			Key:             "host",
+			Value:           json.RawMessage(`"db.example.com"`),
+			Expected:        `{"host":"db.example.com"}`,
+			ExpectedChanged: true,
+		},
+		{
+			Name:            "add key",
+			SecretString:    `{"token": "abc"}`,
+			Key:             "port",
+			Value:           json.RawMessage(`5432`),
+			Expected:        `{"port":5432,"token":"abc"}`,
+			ExpectedChanged: true,
+		},
+		{
+			Name:            "replace key",
+			SecretString:    `{"host":"old","token":"abc"}`,
+			Key:             "host",
+			Value:           json.RawMessage(`"new"`),
+			Expected:        `{"host":"new","token":"abc"}`,
+			ExpectedChanged: true,
+		},
+		{
+			Name:         "unchanged key",
+			SecretString: `{"host": "db", "token": "abc"}`,
+			Key:          "host",
+			Value:        json.RawMessage(`"db"`),
+			Expected:     `{"host": "db", "token": "abc"}`,
+		},
+		{
+			Name:            "remove key",
+			SecretString:    `{"host":"db","token":"abc"}`,
+			Key:             "host",
+			Expected:        `{"token":"abc"}`,
+			ExpectedChanged: true,
+		},
+		{
+			Name:         "remove missing key",
+			SecretString: `{"token":"abc"}`,
+			Key:          "host",
+			Expected:     `{"token":"abc"}`,
+		},
+		{
+			Name:          "not an object",
+			SecretString:  `plaintext`,
+			Key:           "host",
+			Value:         json.RawMessage(`"db"`),
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, changed, err := tfsecretsmanager.SecretKeyMergeDocument(testCase.SecretString, testCase.Key, testCase.Value)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError {
+				return
+			}
+
+			if changed != testCase.ExpectedChanged {
+				t.Errorf("expected changed %t, got %t", testCase.ExpectedChanged, changed)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("expected %s, got %s", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestSecretKeyParseResourceID(t *testing.T) {
+	secretID, key, err := tfsecretsmanager.SecretKeyParseResourceID("arn:aws:secretsmanager:us-east-1:123456789012:secret:example-123456|db|host")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "arn:aws:secretsmanager:us-east-1:123456789012:secret:example-123456"; secretID != expected {
+		t.Errorf("expected secret ID %s, got %s", expected, secretID)
+	}
+
+	if expected := "db|host"; key != expected {
+		t.Errorf("expected key %s, got %s", expected, key)
+	}
+
+	if _, _, err := tfsecretsmanager.SecretKeyParseResourceID("example"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestAccSecretsManagerSecretKey_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_secretsmanager_secret_key.test1"
+	resourceName2 := "aws_secretsmanager_secret_key.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSecretKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretKeyConfig_basic(rName, "db.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(resourceName1),
+					testAccCheckSecretKeyExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName1, "key", "host"),
+					resource.TestCheckResourceAttr(resourceName1, "value", "db.example.com"),
+					resource.TestCheckResourceAttrSet(resourceName1, "version_id"),
+					resource.TestCheckResourceAttr(resourceName2, "key", "port"),
+					resource.TestCheckResourceAttr(resourceName2, "value_json", "5432"),
+				),
+			},
+			{
+				ResourceName:            resourceName1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"version_id"},
+			},
+			{
+				Config: testAccSecretKeyConfig_basic(rName, "db2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(resourceName1),
+					testAccCheckSecretKeyExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName1, "value", "db2.example.com"),
+					resource.TestCheckResourceAttr(resourceName2, "value_json", "5432"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretKey_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_key.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSecretKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretKeyConfig_basic(rName, "db.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsecretsmanager.ResourceSecretKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSecretKeyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_secretsmanager_secret_key" {
+			continue
+		}
+
+		_, err := testAccFindSecretKey(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if tfawserr.ErrMessageContains(err, secretsmanager.ErrCodeInvalidRequestException, "was deleted") || tfawserr.ErrMessageContains(err, secretsmanager.ErrCodeInvalidRequestException, "was marked for deletion") {
+			continue
+		}
+
+		if err == errSecretKeyNotFound {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Secrets Manager Secret Key %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSecretKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Secrets Manager Secret Key ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerConn
+
+		_, err := testAccFindSecretKey(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+var errSecretKeyNotFound = fmt.Errorf("key not found")
+
+func testAccFindSecretKey(conn *secretsmanager.SecretsManager, id string) (json.RawMessage, error) {
+	secretID, key, err := tfsecretsmanager.SecretKeyParseResourceID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := conn.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(secretID),
+		VersionStage: aws.String("AWSCURRENT"),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	document := make(map[string]json.RawMessage)
+
+	if err := json.Unmarshal([]byte(aws.StringValue(output.SecretString)), &document); err != nil {
+		return nil, err
+	}
+
+	v, ok := document[key]
+
+	if !ok {
+		return nil, errSecretKeyNotFound
+	}
+
+	return v, nil
+}
+
+func testAccSecretKeyConfig_basic(rName, host string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({ token = "abc" })
+
+  lifecycle {
+    ignore_changes = [secret_string]
+  }
+}
+
+resource "aws_secretsmanager_secret_key" "test1" {
+  secret_id = aws_secretsmanager_secret_version.test.secret_id
+  key       = "host"
+  value     = %[2]q
+}
+
+resource "aws_secretsmanager_secret_key" "test2" {
+  secret_id  = aws_secretsmanager_secret_version.test.secret_id
+  key        = "port"
+  value_json = jsonencode(5432)
+}
+`, rName, host)
+}
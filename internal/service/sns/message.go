@@ -0,0 +1,231 @@
+package sns
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var messageAttributeDataTypeRegexp = regexp.MustCompile(`^(String|Number|Binary)(\..+)?$`)
+
+func ResourceMessage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMessageCreate,
+		Read:   resourceMessageRead,
+		Delete: schema.Noop,
+
+		Schema: map[string]*schema.Schema{
+			"message": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262_144),
+			},
+			"message_attribute": messageAttributeSchema(),
+			"message_deduplication_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"message_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"message_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message_structure": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"json"}, false),
+			},
+			"sequence_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"topic_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func messageAttributeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"binary_value": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsBase64,
+				},
+				"data_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringMatch(messageAttributeDataTypeRegexp, "must be String, String.Array, Number or Binary, optionally followed by a custom type label"),
+				},
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				"string_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceMessageCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	topicARN := d.Get("topic_arn").(string)
+
+	if _, err := FindTopicAttributesByARN(conn, topicARN); err != nil {
+		return fmt.Errorf("error reading SNS Topic (%s): %w", topicARN, err)
+	}
+
+	input := &sns.PublishInput{
+		Message:  aws.String(d.Get("message").(string)),
+		TopicArn: aws.String(topicARN),
+	}
+
+	if v, ok := d.GetOk("message_attribute"); ok && v.(*schema.Set).Len() > 0 {
+		attributes, err := expandMessageAttributeValues(v.(*schema.Set).List())
+
+		if err != nil {
+			return err
+		}
+
+		input.MessageAttributes = attributes
+	}
+
+	if v, ok := d.GetOk("message_deduplication_id"); ok {
+		input.MessageDeduplicationId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("message_group_id"); ok {
+		input.MessageGroupId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("message_structure"); ok {
+		input.MessageStructure = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subject"); ok {
+		input.Subject = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Publishing SNS Message: %s", input)
+	output, err := conn.Publish(input)
+
+	if err != nil {
+		return fmt.Errorf("error publishing SNS Message to topic (%s): %w", topicARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.MessageId))
+	d.Set("message_id", output.MessageId)
+	d.Set("sequence_number", output.SequenceNumber)
+
+	return resourceMessageRead(d, meta)
+}
+
+// resourceMessageRead only checks that the topic still exists.
+// Published messages cannot be read back.
+func resourceMessageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	topicARN := d.Get("topic_arn").(string)
+
+	_, err := FindTopicAttributesByARN(conn, topicARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SNS Topic (%s) for SNS Message (%s) not found, removing from state", topicARN, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SNS Topic (%s): %w", topicARN, err)
+	}
+
+	return nil
+}
+
+func expandMessageAttributeValues(tfList []interface{}) (map[string]*sns.MessageAttributeValue, error) {
+	apiObjects := make(map[string]*sns.MessageAttributeValue)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		apiObject := &sns.MessageAttributeValue{
+			DataType: aws.String(tfMap["data_type"].(string)),
+		}
+
+		binaryValue, stringValue := tfMap["binary_value"].(string), tfMap["string_value"].(string)
+
+		if (binaryValue == "") == (stringValue == "") {
+			return nil, fmt.Errorf("message attribute (%s): exactly one of binary_value or string_value must be set", name)
+		}
+
+		if binaryValue != "" {
+			v, err := base64.StdEncoding.DecodeString(binaryValue)
+
+			if err != nil {
+				return nil, fmt.Errorf("message attribute (%s): decoding binary_value: %w", name, err)
+			}
+
+			apiObject.BinaryValue = v
+		} else {
+			apiObject.StringValue = aws.String(stringValue)
+		}
+
+		apiObjects[name] = apiObject
+	}
+
+	return apiObjects, nil
+}
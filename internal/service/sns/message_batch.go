@@ -0,0 +1,222 @@
+package sns
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMessageBatch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMessageBatchCreate,
+		Read:   resourceMessageBatchRead,
+		Delete: schema.Noop,
+
+		Schema: map[string]*schema.Schema{
+			"message": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 80),
+						},
+						"message": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 262_144),
+						},
+						"message_attribute": messageAttributeSchema(),
+						"message_deduplication_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"message_group_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"message_structure": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"json"}, false),
+						},
+						"subject": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
+			"message_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sequence_numbers": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"topic_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceMessageBatchCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	topicARN := d.Get("topic_arn").(string)
+
+	if _, err := FindTopicAttributesByARN(conn, topicARN); err != nil {
+		return fmt.Errorf("error reading SNS Topic (%s): %w", topicARN, err)
+	}
+
+	entries, err := expandPublishBatchRequestEntries(d.Get("message").([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	input := &sns.PublishBatchInput{
+		PublishBatchRequestEntries: entries,
+		TopicArn:                   aws.String(topicARN),
+	}
+
+	log.Printf("[DEBUG] Publishing SNS Message batch: %s", input)
+	output, err := conn.PublishBatch(input)
+
+	if err != nil {
+		return fmt.Errorf("error publishing SNS Message batch to topic (%s): %w", topicARN, err)
+	}
+
+	if len(output.Failed) > 0 {
+		var errs []string
+
+		for _, v := range output.Failed {
+			errs = append(errs, fmt.Sprintf("%s: %s: %s", aws.StringValue(v.Id), aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		// Successfully published messages cannot be recalled, so the batch is only partially published.
+		return fmt.Errorf("error publishing SNS Message batch to topic (%s): %d of %d messages failed: %s", topicARN, len(output.Failed), len(entries), strings.Join(errs, ", "))
+	}
+
+	messageIDs := make(map[string]string)
+	sequenceNumbers := make(map[string]string)
+
+	for _, v := range output.Successful {
+		messageIDs[aws.StringValue(v.Id)] = aws.StringValue(v.MessageId)
+
+		if v.SequenceNumber != nil {
+			sequenceNumbers[aws.StringValue(v.Id)] = aws.StringValue(v.SequenceNumber)
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("message_ids", messageIDs)
+	d.Set("sequence_numbers", sequenceNumbers)
+
+	return resourceMessageBatchRead(d, meta)
+}
+
+// resourceMessageBatchRead only checks that the topic still exists.
+// Published messages cannot be read back.
+func resourceMessageBatchRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	topicARN := d.Get("topic_arn").(string)
+
+	_, err := FindTopicAttributesByARN(conn, topicARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SNS Topic (%s) for SNS Message batch (%s) not found, removing from state", topicARN, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SNS Topic (%s): %w", topicARN, err)
+	}
+
+	return nil
+}
+
+func expandPublishBatchRequestEntries(tfList []interface{}) ([]*sns.PublishBatchRequestEntry, error) {
+	var apiObjects []*sns.PublishBatchRequestEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &sns.PublishBatchRequestEntry{
+			Id:      aws.String(tfMap["id"].(string)),
+			Message: aws.String(tfMap["message"].(string)),
+		}
+
+		if v, ok := tfMap["message_attribute"].(*schema.Set); ok && v.Len() > 0 {
+			attributes, err := expandMessageAttributeValues(v.List())
+
+			if err != nil {
+				return nil, fmt.Errorf("message (%s): %w", aws.StringValue(apiObject.Id), err)
+			}
+
+			apiObject.MessageAttributes = attributes
+		}
+
+		if v, ok := tfMap["message_deduplication_id"].(string); ok && v != "" {
+			apiObject.MessageDeduplicationId = aws.String(v)
+		}
+
+		if v, ok := tfMap["message_group_id"].(string); ok && v != "" {
+			apiObject.MessageGroupId = aws.String(v)
+		}
+
+		if v, ok := tfMap["message_structure"].(string); ok && v != "" {
+			apiObject.MessageStructure = aws.String(v)
+		}
+
+		if v, ok := tfMap["subject"].(string); ok && v != "" {
+			apiObject.Subject = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
@@ -0,0 +1,63 @@
+package sns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sns"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSNSMessageBatch_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_message_batch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sns.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageBatchConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "message.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "message_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "message_ids.first"),
+					resource.TestCheckResourceAttrSet(resourceName, "message_ids.second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMessageBatchConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_message_batch" "test" {
+  topic_arn = aws_sns_topic.test.arn
+
+  message {
+    id      = "first"
+    message = "first"
+  }
+
+  message {
+    id      = "second"
+    message = "second"
+    subject = "second"
+
+    message_attribute {
+      name         = "source"
+      data_type    = "String"
+      string_value = "terraform"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,92 @@
+package sns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sns"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSNSMessage_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_message.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sns.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "topic_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "message_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "message_id"),
+					resource.TestCheckResourceAttr(resourceName, "subject", "migration"),
+					resource.TestCheckResourceAttr(resourceName, "message_attribute.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSMessage_fifo(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_message.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sns.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageConfigFIFO(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "message_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "sequence_number"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMessageConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_message" "test" {
+  topic_arn = aws_sns_topic.test.arn
+  subject   = "migration"
+  message   = jsonencode({ action = "migrate" })
+
+  message_attribute {
+    name         = "source"
+    data_type    = "String"
+    string_value = "terraform"
+  }
+}
+`, rName)
+}
+
+func testAccMessageConfigFIFO(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name       = "%[1]s.fifo"
+  fifo_topic = true
+}
+
+resource "aws_sns_message" "test" {
+  topic_arn                = aws_sns_topic.test.arn
+  message                  = "migrate"
+  message_group_id         = "migrations"
+  message_deduplication_id = "migrate-1"
+}
+`, rName)
+}
@@ -0,0 +1,224 @@
+package sqs
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+var messageAttributeDataTypeRegexp = regexp.MustCompile(`^(String|Number|Binary)(\..+)?$`)
+
+func ResourceMessage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMessageCreate,
+		Read:   resourceMessageRead,
+		Delete: schema.Noop,
+
+		Schema: map[string]*schema.Schema{
+			"delay_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 900),
+			},
+			"md5_of_message_body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message_attribute": messageAttributeSchema(),
+			"message_body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262_144),
+			},
+			"message_deduplication_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"message_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"message_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sequence_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func messageAttributeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"binary_value": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsBase64,
+				},
+				"data_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringMatch(messageAttributeDataTypeRegexp, "must be String, Number or Binary, optionally followed by a custom type label"),
+				},
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				"string_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceMessageCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	url := d.Get("queue_url").(string)
+
+	if _, err := FindQueueAttributesByURL(conn, url); err != nil {
+		return fmt.Errorf("error reading SQS Queue (%s): %w", url, err)
+	}
+
+	input := &sqs.SendMessageInput{
+		MessageBody: aws.String(d.Get("message_body").(string)),
+		QueueUrl:    aws.String(url),
+	}
+
+	if v, ok := d.GetOk("delay_seconds"); ok {
+		input.DelaySeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("message_attribute"); ok && v.(*schema.Set).Len() > 0 {
+		attributes, err := expandMessageAttributeValues(v.(*schema.Set).List())
+
+		if err != nil {
+			return err
+		}
+
+		input.MessageAttributes = attributes
+	}
+
+	if v, ok := d.GetOk("message_deduplication_id"); ok {
+		input.MessageDeduplicationId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("message_group_id"); ok {
+		input.MessageGroupId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Sending SQS Message: %s", input)
+	output, err := conn.SendMessage(input)
+
+	if err != nil {
+		return fmt.Errorf("error sending SQS Message to queue (%s): %w", url, err)
+	}
+
+	d.SetId(aws.StringValue(output.MessageId))
+	d.Set("md5_of_message_body", output.MD5OfMessageBody)
+	d.Set("message_id", output.MessageId)
+	d.Set("sequence_number", output.SequenceNumber)
+
+	return resourceMessageRead(d, meta)
+}
+
+// resourceMessageRead only checks that the queue still exists.
+// Sent messages cannot be read back without consuming them.
+func resourceMessageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	url := d.Get("queue_url").(string)
+
+	_, err := FindQueueAttributesByURL(conn, url)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue (%s) for SQS Message (%s) not found, removing from state", url, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Queue (%s): %w", url, err)
+	}
+
+	return nil
+}
+
+func expandMessageAttributeValues(tfList []interface{}) (map[string]*sqs.MessageAttributeValue, error) {
+	apiObjects := make(map[string]*sqs.MessageAttributeValue)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		apiObject := &sqs.MessageAttributeValue{
+			DataType: aws.String(tfMap["data_type"].(string)),
+		}
+
+		binaryValue, stringValue := tfMap["binary_value"].(string), tfMap["string_value"].(string)
+
+		if (binaryValue == "") == (stringValue == "") {
+			return nil, fmt.Errorf("message attribute (%s): exactly one of binary_value or string_value must be set", name)
+		}
+
+		if binaryValue != "" {
+			v, err := base64.StdEncoding.DecodeString(binaryValue)
+
+			if err != nil {
+				return nil, fmt.Errorf("message attribute (%s): decoding binary_value: %w", name, err)
+			}
+
+			apiObject.BinaryValue = v
+		} else {
+			apiObject.StringValue = aws.String(stringValue)
+		}
+
+		apiObjects[name] = apiObject
+	}
+
+	return apiObjects, nil
+}
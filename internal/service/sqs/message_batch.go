@@ -0,0 +1,210 @@
+package sqs
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceMessageBatch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMessageBatchCreate,
+		Read:   resourceMessageBatchRead,
+		Delete: schema.Noop,
+
+		Schema: map[string]*schema.Schema{
+			"message": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delay_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 900),
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 80),
+						},
+						"message_attribute": messageAttributeSchema(),
+						"message_body": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 262_144),
+						},
+						"message_deduplication_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"message_group_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+					},
+				},
+			},
+			"message_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sequence_numbers": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceMessageBatchCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	url := d.Get("queue_url").(string)
+
+	if _, err := FindQueueAttributesByURL(conn, url); err != nil {
+		return fmt.Errorf("error reading SQS Queue (%s): %w", url, err)
+	}
+
+	entries, err := expandSendMessageBatchRequestEntries(d.Get("message").([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	input := &sqs.SendMessageBatchInput{
+		Entries:  entries,
+		QueueUrl: aws.String(url),
+	}
+
+	log.Printf("[DEBUG] Sending SQS Message batch: %s", input)
+	output, err := conn.SendMessageBatch(input)
+
+	if err != nil {
+		return fmt.Errorf("error sending SQS Message batch to queue (%s): %w", url, err)
+	}
+
+	if len(output.Failed) > 0 {
+		var errs []string
+
+		for _, v := range output.Failed {
+			errs = append(errs, fmt.Sprintf("%s: %s: %s", aws.StringValue(v.Id), aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		// Successfully sent messages cannot be recalled, so the batch is only partially sent.
+		return fmt.Errorf("error sending SQS Message batch to queue (%s): %d of %d messages failed: %s", url, len(output.Failed), len(entries), strings.Join(errs, ", "))
+	}
+
+	messageIDs := make(map[string]string)
+	sequenceNumbers := make(map[string]string)
+
+	for _, v := range output.Successful {
+		messageIDs[aws.StringValue(v.Id)] = aws.StringValue(v.MessageId)
+
+		if v.SequenceNumber != nil {
+			sequenceNumbers[aws.StringValue(v.Id)] = aws.StringValue(v.SequenceNumber)
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("message_ids", messageIDs)
+	d.Set("sequence_numbers", sequenceNumbers)
+
+	return resourceMessageBatchRead(d, meta)
+}
+
+// resourceMessageBatchRead only checks that the queue still exists.
+// Sent messages cannot be read back without consuming them.
+func resourceMessageBatchRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	url := d.Get("queue_url").(string)
+
+	_, err := FindQueueAttributesByURL(conn, url)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue (%s) for SQS Message batch (%s) not found, removing from state", url, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Queue (%s): %w", url, err)
+	}
+
+	return nil
+}
+
+func expandSendMessageBatchRequestEntries(tfList []interface{}) ([]*sqs.SendMessageBatchRequestEntry, error) {
+	var apiObjects []*sqs.SendMessageBatchRequestEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &sqs.SendMessageBatchRequestEntry{
+			Id:          aws.String(tfMap["id"].(string)),
+			MessageBody: aws.String(tfMap["message_body"].(string)),
+		}
+
+		if v, ok := tfMap["delay_seconds"].(int); ok && v != 0 {
+			apiObject.DelaySeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["message_attribute"].(*schema.Set); ok && v.Len() > 0 {
+			attributes, err := expandMessageAttributeValues(v.List())
+
+			if err != nil {
+				return nil, fmt.Errorf("message (%s): %w", aws.StringValue(apiObject.Id), err)
+			}
+
+			apiObject.MessageAttributes = attributes
+		}
+
+		if v, ok := tfMap["message_deduplication_id"].(string); ok && v != "" {
+			apiObject.MessageDeduplicationId = aws.String(v)
+		}
+
+		if v, ok := tfMap["message_group_id"].(string); ok && v != "" {
+			apiObject.MessageGroupId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
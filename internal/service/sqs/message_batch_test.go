@@ -0,0 +1,63 @@
+package sqs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSQSMessageBatch_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sqs_message_batch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageBatchConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "message.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "message_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "message_ids.first"),
+					resource.TestCheckResourceAttrSet(resourceName, "message_ids.second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMessageBatchConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_message_batch" "test" {
+  queue_url = aws_sqs_queue.test.url
+
+  message {
+    id           = "first"
+    message_body = "first"
+  }
+
+  message {
+    id            = "second"
+    message_body  = "second"
+    delay_seconds = 10
+
+    message_attribute {
+      name         = "source"
+      data_type    = "String"
+      string_value = "terraform"
+    }
+  }
+}
+`, rName)
+}
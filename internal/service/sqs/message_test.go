@@ -0,0 +1,111 @@
+package sqs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSQSMessage_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sqs_message.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageConfig(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "queue_url", "aws_sqs_queue.test", "url"),
+					resource.TestCheckResourceAttrSet(resourceName, "message_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "message_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "md5_of_message_body"),
+					resource.TestCheckResourceAttr(resourceName, "sequence_number", ""),
+					resource.TestCheckResourceAttr(resourceName, "message_attribute.#", "2"),
+				),
+			},
+			{
+				Config: testAccMessageConfig(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "message_id"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSMessage_fifo(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sqs_message.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMessageConfigFIFO(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "message_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "sequence_number"),
+					resource.TestCheckResourceAttr(resourceName, "message_group_id", "migrations"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMessageConfig(rName, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_message" "test" {
+  queue_url     = aws_sqs_queue.test.url
+  message_body  = jsonencode({ action = "migrate" })
+  delay_seconds = 5
+
+  message_attribute {
+    name         = "source"
+    data_type    = "String"
+    string_value = "terraform"
+  }
+
+  message_attribute {
+    name         = "payload"
+    data_type    = "Binary"
+    binary_value = base64encode("payload")
+  }
+
+  triggers = {
+    run = %[2]q
+  }
+}
+`, rName, trigger)
+}
+
+func testAccMessageConfigFIFO(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name       = "%[1]s.fifo"
+  fifo_queue = true
+}
+
+resource "aws_sqs_message" "test" {
+  queue_url                = aws_sqs_queue.test.url
+  message_body             = "migrate"
+  message_group_id         = "migrations"
+  message_deduplication_id = "migrate-1"
+}
+`, rName)
+}
@@ -0,0 +1,780 @@
+package ssm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// Standard throughput for PutParameter is 3 transactions per second.
+	parametersDefaultWritesPerSecond = 3
+
+	// DeleteParameters accepts at most 10 names per call.
+	parametersDeleteBatchSize = 10
+
+	parametersThrottleTimeout = 2 * time.Minute
+)
+
+func ResourceParameters() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceParametersCreate,
+		Read:   resourceParametersRead,
+		Update: resourceParametersUpdate,
+		Delete: resourceParametersDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("source", parametersSourceImportMarker)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_writes_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      parametersDefaultWritesPerSecond,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateParametersRelativeName,
+						},
+						"tier": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.ParameterTierStandard,
+							ValidateFunc: validation.StringInSlice(ssm.ParameterTier_Values(), false),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.ParameterTypeString,
+							ValidateFunc: validation.StringInSlice(ssm.ParameterType_Values(), false),
+						},
+						"value": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateParametersPath,
+			},
+			"source": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := expandParametersSource(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%q: %w", k, err))
+					}
+					return
+				},
+			},
+			"versions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+// ParameterSpec is the desired state of a single parameter managed by aws_ssm_parameters.
+type ParameterSpec struct {
+	Description string
+	Tier        string
+	Type        string
+	Value       string
+}
+
+func resourceParametersCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	path := d.Get("path").(string)
+
+	desired, err := parametersDesiredState(d.Get("parameter").(*schema.Set), d.Get("source").(string))
+
+	if err != nil {
+		return err
+	}
+
+	writer := newParametersWriter(conn, d.Get("max_writes_per_second").(int))
+	overwrite := d.Get("overwrite").(bool)
+	keyID := d.Get("key_id").(string)
+
+	for _, name := range parametersSortedNames(desired) {
+		if err := writer.put(parametersFullName(path, name), desired[name], keyID, overwrite); err != nil {
+			return fmt.Errorf("error creating SSM Parameters (%s): %w", path, err)
+		}
+	}
+
+	d.SetId(path)
+
+	if d.Get("exclusive").(bool) {
+		remote, err := findParametersByPath(conn, path)
+
+		if err != nil {
+			return fmt.Errorf("error reading SSM Parameters (%s): %w", path, err)
+		}
+
+		if err := writer.delete(parametersUnmanagedNames(path, remote, desired)); err != nil {
+			return fmt.Errorf("error deleting unmanaged SSM Parameters (%s): %w", path, err)
+		}
+	}
+
+	return resourceParametersRead(d, meta)
+}
+
+func resourceParametersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	path := d.Id()
+
+	remote, err := findParametersByPath(conn, path)
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Parameters (%s): %w", path, err)
+	}
+
+	if !d.IsNewResource() && len(remote) == 0 {
+		log.Printf("[WARN] SSM Parameters (%s) not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	source := d.Get("source").(string)
+	sourceSpecs, err := expandParametersSource(parametersSourceDocument(source))
+
+	if err != nil {
+		return err
+	}
+
+	// Parameters declared in blocks are refreshed individually. When importing,
+	// every remote parameter is considered block-managed.
+	blockNames := make(map[string]bool)
+	for _, tfMapRaw := range d.Get("parameter").(*schema.Set).List() {
+		blockNames[tfMapRaw.(map[string]interface{})["name"].(string)] = true
+	}
+	importing := source == parametersSourceImportMarker
+	exclusive := d.Get("exclusive").(bool)
+
+	// Once drift has been recorded the source document is no longer in state, so
+	// the parameters it managed are identified by the previously recorded ARNs.
+	sourceMarked := source == parametersSourceDriftMarker
+	previousARNs := d.Get("arns").(map[string]interface{})
+
+	arns := make(map[string]interface{})
+	versions := make(map[string]interface{})
+	var tfList []interface{}
+	sourceDrifted := sourceMarked
+
+	for fullName, param := range remote {
+		name := parametersRelativeName(path, fullName)
+		_, previouslyTracked := previousARNs[name]
+
+		switch spec, ok := sourceSpecs[name]; {
+		case ok && !blockNames[name]:
+			if !spec.equal(param.spec()) {
+				sourceDrifted = true
+			}
+		case sourceMarked && previouslyTracked && !blockNames[name]:
+			// Still managed by the drifted source document.
+		case blockNames[name] || importing || exclusive:
+			tfList = append(tfList, flattenParameterSpec(name, param.spec()))
+		default:
+			continue
+		}
+
+		arns[name] = param.ARN
+		versions[name] = param.Version
+	}
+
+	for name := range sourceSpecs {
+		if _, ok := remote[parametersFullName(path, name)]; !ok && !blockNames[name] {
+			sourceDrifted = true
+		}
+	}
+
+	d.Set("path", path)
+
+	if err := d.Set("parameter", tfList); err != nil {
+		return fmt.Errorf("error setting parameter: %w", err)
+	}
+
+	// Source documents can't be refreshed field by field, so any drift in the
+	// parameters they declare is surfaced as a change to the document itself.
+	if sourceDrifted {
+		d.Set("source", parametersSourceDriftMarker)
+	}
+
+	if importing {
+		d.Set("source", "")
+	}
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %w", err)
+	}
+
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("error setting versions: %w", err)
+	}
+
+	return nil
+}
+
+func resourceParametersUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	path := d.Id()
+
+	oParams, nParams := d.GetChange("parameter")
+	oSource, nSource := d.GetChange("source")
+
+	desired, err := parametersDesiredState(nParams.(*schema.Set), nSource.(string))
+
+	if err != nil {
+		return err
+	}
+
+	// The previous source may be the drift marker, so names recorded in arns are
+	// also treated as previously managed.
+	previous, _ := parametersDesiredState(oParams.(*schema.Set), oSource.(string))
+
+	for name := range d.Get("arns").(map[string]interface{}) {
+		if _, ok := previous[name]; !ok {
+			previous[name] = ParameterSpec{}
+		}
+	}
+
+	remote, err := findParametersByPath(conn, path)
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Parameters (%s): %w", path, err)
+	}
+
+	writer := newParametersWriter(conn, d.Get("max_writes_per_second").(int))
+	keyID := d.Get("key_id").(string)
+
+	for _, name := range parametersSortedNames(desired) {
+		spec := desired[name]
+
+		if param, ok := remote[parametersFullName(path, name)]; ok && spec.equal(param.spec()) && !d.HasChange("key_id") {
+			continue
+		}
+
+		if err := writer.put(parametersFullName(path, name), spec, keyID, true); err != nil {
+			return fmt.Errorf("error updating SSM Parameters (%s): %w", path, err)
+		}
+	}
+
+	var names []string
+
+	if d.Get("exclusive").(bool) {
+		names = parametersUnmanagedNames(path, remote, desired)
+	} else {
+		for name := range previous {
+			if _, ok := desired[name]; !ok {
+				names = append(names, parametersFullName(path, name))
+			}
+		}
+	}
+
+	if err := writer.delete(names); err != nil {
+		return fmt.Errorf("error deleting SSM Parameters (%s): %w", path, err)
+	}
+
+	return resourceParametersRead(d, meta)
+}
+
+func resourceParametersDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	path := d.Id()
+
+	desired, err := parametersDesiredState(d.Get("parameter").(*schema.Set), d.Get("source").(string))
+
+	if err != nil {
+		return err
+	}
+
+	var names []string
+
+	if d.Get("exclusive").(bool) {
+		remote, err := findParametersByPath(conn, path)
+
+		if err != nil {
+			return fmt.Errorf("error reading SSM Parameters (%s): %w", path, err)
+		}
+
+		for name := range remote {
+			names = append(names, name)
+		}
+	} else {
+		// The source may be the drift marker, so names recorded in arns are also deleted.
+		for name := range d.Get("arns").(map[string]interface{}) {
+			if _, ok := desired[name]; !ok {
+				desired[name] = ParameterSpec{}
+			}
+		}
+
+		for name := range desired {
+			names = append(names, parametersFullName(path, name))
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting SSM Parameters (%s): %d parameters", path, len(names))
+	writer := newParametersWriter(conn, d.Get("max_writes_per_second").(int))
+
+	if err := writer.delete(names); err != nil {
+		return fmt.Errorf("error deleting SSM Parameters (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+const (
+	// parametersSourceDriftMarker replaces the configured source document in state
+	// when the parameters it declares no longer match AWS, forcing an update.
+	// It is not a valid source document, so it can't match any configuration.
+	parametersSourceDriftMarker = "(drifted)"
+
+	// parametersSourceImportMarker is recorded by the importer so that the
+	// following read adopts every parameter under the path.
+	parametersSourceImportMarker = "(imported)"
+)
+
+// parametersSourceDocument returns the source document recorded in state, ignoring markers.
+func parametersSourceDocument(source string) string {
+	if source == parametersSourceDriftMarker || source == parametersSourceImportMarker {
+		return ""
+	}
+
+	return source
+}
+
+// expandParametersSource parses a YAML or JSON document mapping parameter names
+// (relative to the resource path) to either a plain string value or an object
+// with "value", "type", "tier" and "description" keys.
+func expandParametersSource(source string) (map[string]ParameterSpec, error) {
+	specs := make(map[string]ParameterSpec)
+
+	if strings.TrimSpace(source) == "" {
+		return specs, nil
+	}
+
+	var document map[string]interface{}
+
+	if err := yaml.Unmarshal([]byte(source), &document); err != nil {
+		return nil, fmt.Errorf("parsing SSM Parameters source: %w", err)
+	}
+
+	for name, v := range document {
+		if _, errs := validateParametersRelativeName(name, "source"); len(errs) > 0 {
+			return nil, errs[0]
+		}
+
+		spec := ParameterSpec{
+			Tier: ssm.ParameterTierStandard,
+			Type: ssm.ParameterTypeString,
+		}
+
+		switch v := v.(type) {
+		case map[interface{}]interface{}:
+			for key, value := range v {
+				s, ok := parametersSourceScalar(value)
+
+				if !ok {
+					return nil, fmt.Errorf("parsing SSM Parameters source: %s.%v must be a string", name, key)
+				}
+
+				switch key {
+				case "description":
+					spec.Description = s
+				case "tier":
+					spec.Tier = s
+				case "type":
+					spec.Type = s
+				case "value":
+					spec.Value = s
+				default:
+					return nil, fmt.Errorf("parsing SSM Parameters source: %s: unsupported key %q", name, key)
+				}
+			}
+		default:
+			s, ok := parametersSourceScalar(v)
+
+			if !ok {
+				return nil, fmt.Errorf("parsing SSM Parameters source: %s must be a string or an object", name)
+			}
+
+			spec.Value = s
+		}
+
+		if spec.Value == "" {
+			return nil, fmt.Errorf("parsing SSM Parameters source: %s: value is required", name)
+		}
+
+		if !parametersStringInSlice(spec.Type, ssm.ParameterType_Values()) {
+			return nil, fmt.Errorf("parsing SSM Parameters source: %s: invalid type %q", name, spec.Type)
+		}
+
+		if !parametersStringInSlice(spec.Tier, ssm.ParameterTier_Values()) {
+			return nil, fmt.Errorf("parsing SSM Parameters source: %s: invalid tier %q", name, spec.Tier)
+		}
+
+		specs[name] = spec
+	}
+
+	return specs, nil
+}
+
+// parametersSourceScalar converts a scalar source value to its string form.
+// Unquoted YAML scalars such as `8080` or `true` are decoded as numbers and
+// booleans, but are stored in Parameter Store as strings.
+func parametersSourceScalar(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case bool, int, int64, uint64, float64:
+		return fmt.Sprint(v), true
+	default:
+		return "", false
+	}
+}
+
+// parametersDesiredState merges the source document with the parameter blocks.
+// Blocks take precedence over source entries with the same name.
+func parametersDesiredState(tfSet *schema.Set, source string) (map[string]ParameterSpec, error) {
+	desired, err := expandParametersSource(parametersSourceDocument(source))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		desired[tfMap["name"].(string)] = ParameterSpec{
+			Description: tfMap["description"].(string),
+			Tier:        tfMap["tier"].(string),
+			Type:        tfMap["type"].(string),
+			Value:       tfMap["value"].(string),
+		}
+	}
+
+	return desired, nil
+}
+
+func flattenParameterSpec(name string, spec ParameterSpec) map[string]interface{} {
+	return map[string]interface{}{
+		"description": spec.Description,
+		"name":        name,
+		"tier":        spec.Tier,
+		"type":        spec.Type,
+		"value":       spec.Value,
+	}
+}
+
+func (s ParameterSpec) equal(o ParameterSpec) bool {
+	// Intelligent-Tiering resolves to either Standard or Advanced.
+	if s.Tier == ssm.ParameterTierIntelligentTiering {
+		o.Tier = s.Tier
+	}
+
+	return s == o
+}
+
+// remoteParameter combines the GetParametersByPath and DescribeParameters views of a parameter.
+type remoteParameter struct {
+	ARN         string
+	Description string
+	Tier        string
+	Type        string
+	Value       string
+	Version     int
+}
+
+func (p *remoteParameter) spec() ParameterSpec {
+	return ParameterSpec{
+		Description: p.Description,
+		Tier:        p.Tier,
+		Type:        p.Type,
+		Value:       p.Value,
+	}
+}
+
+// findParametersByPath returns all parameters beneath the specified path, keyed by full name.
+func findParametersByPath(conn *ssm.SSM, path string) (map[string]*remoteParameter, error) {
+	params := make(map[string]*remoteParameter)
+
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+
+	err := conn.GetParametersByPathPages(input, func(page *ssm.GetParametersByPathOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, param := range page.Parameters {
+			if param == nil {
+				continue
+			}
+
+			params[aws.StringValue(param.Name)] = &remoteParameter{
+				ARN:     aws.StringValue(param.ARN),
+				Tier:    ssm.ParameterTierStandard,
+				Type:    aws.StringValue(param.Type),
+				Value:   aws.StringValue(param.Value),
+				Version: int(aws.Int64Value(param.Version)),
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) == 0 {
+		return params, nil
+	}
+
+	// Description and tier are only available from DescribeParameters.
+	describeInput := &ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{
+			{
+				Key:    aws.String("Path"),
+				Option: aws.String("Recursive"),
+				Values: aws.StringSlice([]string{path}),
+			},
+		},
+	}
+
+	err = conn.DescribeParametersPages(describeInput, func(page *ssm.DescribeParametersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, detail := range page.Parameters {
+			if detail == nil {
+				continue
+			}
+
+			if param, ok := params[aws.StringValue(detail.Name)]; ok {
+				param.Description = aws.StringValue(detail.Description)
+				if detail.Tier != nil {
+					param.Tier = aws.StringValue(detail.Tier)
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// parametersWriter spaces out write calls so that bulk changes stay within
+// the PutParameter throughput limit, retrying any throttled requests.
+type parametersWriter struct {
+	conn     *ssm.SSM
+	interval time.Duration
+	last     time.Time
+}
+
+func newParametersWriter(conn *ssm.SSM, writesPerSecond int) *parametersWriter {
+	return &parametersWriter{
+		conn:     conn,
+		interval: time.Second / time.Duration(writesPerSecond),
+	}
+}
+
+func (w *parametersWriter) wait() {
+	if d := time.Until(w.last.Add(w.interval)); d > 0 {
+		time.Sleep(d)
+	}
+
+	w.last = time.Now()
+}
+
+func (w *parametersWriter) put(name string, spec ParameterSpec, keyID string, overwrite bool) error {
+	input := &ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Overwrite: aws.Bool(overwrite),
+		Tier:      aws.String(spec.Tier),
+		Type:      aws.String(spec.Type),
+		Value:     aws.String(spec.Value),
+	}
+
+	if spec.Description != "" {
+		input.Description = aws.String(spec.Description)
+	}
+
+	if keyID != "" && spec.Type == ssm.ParameterTypeSecureString {
+		input.KeyId = aws.String(keyID)
+	}
+
+	log.Printf("[DEBUG] Putting SSM Parameter: %s", name)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(parametersThrottleTimeout, func() (interface{}, error) {
+		w.wait()
+		return w.conn.PutParameter(input)
+	}, "ThrottlingException", ssm.ErrCodeTooManyUpdates)
+
+	if err != nil {
+		return fmt.Errorf("putting SSM Parameter (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func (w *parametersWriter) delete(names []string) error {
+	sort.Strings(names)
+
+	for len(names) > 0 {
+		n := parametersDeleteBatchSize
+		if len(names) < n {
+			n = len(names)
+		}
+
+		input := &ssm.DeleteParametersInput{
+			Names: aws.StringSlice(names[:n]),
+		}
+
+		log.Printf("[DEBUG] Deleting SSM Parameters: %s", input)
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(parametersThrottleTimeout, func() (interface{}, error) {
+			w.wait()
+			return w.conn.DeleteParameters(input)
+		}, "ThrottlingException", ssm.ErrCodeTooManyUpdates)
+
+		if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) {
+			err = nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting SSM Parameters (%s): %w", strings.Join(names[:n], ", "), err)
+		}
+
+		names = names[n:]
+	}
+
+	return nil
+}
+
+func parametersFullName(path, name string) string {
+	return path + "/" + name
+}
+
+func parametersRelativeName(path, fullName string) string {
+	return strings.TrimPrefix(fullName, path+"/")
+}
+
+func parametersSortedNames(specs map[string]ParameterSpec) []string {
+	names := make([]string, 0, len(specs))
+
+	for name := range specs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func parametersUnmanagedNames(path string, remote map[string]*remoteParameter, desired map[string]ParameterSpec) []string {
+	var names []string
+
+	for fullName := range remote {
+		if _, ok := desired[parametersRelativeName(path, fullName)]; !ok {
+			names = append(names, fullName)
+		}
+	}
+
+	return names
+}
+
+func parametersStringInSlice(s string, values []string) bool {
+	for _, v := range values {
+		if s == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateParametersPath(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !strings.HasPrefix(value, "/") || strings.HasSuffix(value, "/") || len(value) < 2 {
+		errors = append(errors, fmt.Errorf("%q must begin with a slash and must not end with a slash: %q", k, value))
+	}
+
+	return
+}
+
+func validateParametersRelativeName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" || strings.HasPrefix(value, "/") || strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must be a non-empty name relative to path: %q", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,144 @@
+package ssm
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+func TestExpandParametersSource(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Source        string
+		Expected      map[string]ParameterSpec
+		ExpectedError bool
+	}{
+		{
+			Name:     "empty",
+			Source:   "",
+			Expected: map[string]ParameterSpec{},
+		},
+		{
+			Name:   "yaml",
+			Source: "log_level: info\nfeature/flags:\n  value: a,b\n  type: StringList\n  description: flags\n",
+			Expected: map[string]ParameterSpec{
+				"log_level": {
+					Tier:  ssm.ParameterTierStandard,
+					Type:  ssm.ParameterTypeString,
+					Value: "info",
+				},
+				"feature/flags": {
+					Description: "flags",
+					Tier:        ssm.ParameterTierStandard,
+					Type:        ssm.ParameterTypeStringList,
+					Value:       "a,b",
+				},
+			},
+		},
+		{
+			Name:   "json",
+			Source: `{"secret": {"value": "s3cr3t", "type": "SecureString", "tier": "Advanced"}}`,
+			Expected: map[string]ParameterSpec{
+				"secret": {
+					Tier:  ssm.ParameterTierAdvanced,
+					Type:  ssm.ParameterTypeSecureString,
+					Value: "s3cr3t",
+				},
+			},
+		},
+		{
+			Name:   "yaml scalars",
+			Source: "port: 8080\nenabled: true\nratio: 0.5\nlimit:\n  value: 10\n",
+			Expected: map[string]ParameterSpec{
+				"port": {
+					Tier:  ssm.ParameterTierStandard,
+					Type:  ssm.ParameterTypeString,
+					Value: "8080",
+				},
+				"enabled": {
+					Tier:  ssm.ParameterTierStandard,
+					Type:  ssm.ParameterTypeString,
+					Value: "true",
+				},
+				"ratio": {
+					Tier:  ssm.ParameterTierStandard,
+					Type:  ssm.ParameterTypeString,
+					Value: "0.5",
+				},
+				"limit": {
+					Tier:  ssm.ParameterTierStandard,
+					Type:  ssm.ParameterTypeString,
+					Value: "10",
+				},
+			},
+		},
+		{
+			Name:          "null value",
+			Source:        "port:\n",
+			ExpectedError: true,
+		},
+		{
+			Name:          "absolute name",
+			Source:        `{"/secret": "value"}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "invalid type",
+			Source:        `{"secret": {"value": "value", "type": "Number"}}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "unsupported key",
+			Source:        `{"secret": {"value": "value", "key_id": "alias/example"}}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "missing value",
+			Source:        `{"secret": {"type": "String"}}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "drift marker",
+			Source:        parametersSourceDriftMarker,
+			ExpectedError: true,
+		},
+		{
+			Name:          "import marker",
+			Source:        parametersSourceImportMarker,
+			ExpectedError: true,
+		},
+		{
+			Name:          "invalid document",
+			Source:        `["value"]`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := expandParametersSource(testCase.Source)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError {
+				return
+			}
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("expected %d parameters, got %d", len(testCase.Expected), len(got))
+			}
+
+			for name, expected := range testCase.Expected {
+				if got[name] != expected {
+					t.Errorf("parameter %s: expected %#v, got %#v", name, expected, got[name])
+				}
+			}
+		})
+	}
+}
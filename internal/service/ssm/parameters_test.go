@@ -0,0 +1,252 @@
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParametersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "path", "/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "one",
+						"type":  "String",
+						"tier":  "Standard",
+						"value": "value1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "arns.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.%", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"overwrite"},
+			},
+			{
+				Config: testAccParametersConfig(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, 2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "one",
+						"value": "value2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "versions.one", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_source(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParametersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersSourceConfig(rName, `{"a": "1", "b": {"value": "2", "type": "StringList"}}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "arns.%", "2"),
+				),
+			},
+			{
+				Config: testAccParametersSourceConfig(rName, `{"a": "1"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "arns.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_exclusive(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParametersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, 2),
+					testAccCheckParametersPutUnmanaged(resourceName, "unmanaged"),
+				),
+			},
+			{
+				Config: testAccParametersExclusiveConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParametersExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Parameters ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+		var names []string
+
+		err := conn.GetParametersByPathPages(&ssm.GetParametersByPathInput{
+			Path:      aws.String(rs.Primary.ID),
+			Recursive: aws.Bool(true),
+		}, func(page *ssm.GetParametersByPathOutput, lastPage bool) bool {
+			for _, param := range page.Parameters {
+				names = append(names, aws.StringValue(param.Name))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(names) != count {
+			return fmt.Errorf("expected %d SSM Parameters beneath %s, got %d: %v", count, rs.Primary.ID, len(names), names)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersPutUnmanaged(n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+		_, err := conn.PutParameter(&ssm.PutParameterInput{
+			Name:  aws.String(rs.Primary.ID + "/" + name),
+			Type:  aws.String(ssm.ParameterTypeString),
+			Value: aws.String("unmanaged"),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckParametersDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssm_parameters" {
+			continue
+		}
+
+		output, err := conn.GetParametersByPath(&ssm.GetParametersByPathInput{
+			Path:      aws.String(rs.Primary.ID),
+			Recursive: aws.Bool(true),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading SSM Parameters (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output == nil || len(output.Parameters) == 0 {
+			continue
+		}
+
+		return fmt.Errorf("SSM Parameters (%s) still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccParametersConfig(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name  = "one"
+    value = %[2]q
+  }
+
+  parameter {
+    name        = "nested/two"
+    type        = "SecureString"
+    value       = "secret"
+    description = "test"
+  }
+}
+`, rName, value)
+}
+
+func testAccParametersExclusiveConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path      = "/%[1]s"
+  exclusive = true
+
+  parameter {
+    name  = "one"
+    value = "value1"
+  }
+
+  parameter {
+    name        = "nested/two"
+    type        = "SecureString"
+    value       = "secret"
+    description = "test"
+  }
+}
+`, rName)
+}
+
+func testAccParametersSourceConfig(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path   = "/%[1]s"
+  source = %[2]q
+}
+`, rName, source)
+}
@@ -0,0 +1,473 @@
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Maximum number of account assignments created or deleted concurrently.
+	organizationalUnitAssignmentConcurrency = 5
+)
+
+func ResourceOrganizationalUnitAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOrganizationalUnitAssignmentCreate,
+		Read:   resourceOrganizationalUnitAssignmentRead,
+		Update: resourceOrganizationalUnitAssignmentUpdate,
+		Delete: resourceOrganizationalUnitAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceOrganizationalUnitAssignmentImport,
+		},
+
+		CustomizeDiff: resourceOrganizationalUnitAssignmentCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"created_account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"organizational_unit_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(r-[0-9a-z]{4,32}|ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$`), "must be an organizational unit or root ID"),
+			},
+
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"principal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 47),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}$`), "must match ([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}"),
+				),
+			},
+
+			"principal_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssoadmin.PrincipalType_Values(), false),
+			},
+
+			"recursive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceOrganizationalUnitAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	instanceArn := d.Get("instance_arn").(string)
+	ouID := d.Get("organizational_unit_id").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+	principalID := d.Get("principal_id").(string)
+	principalType := d.Get("principal_type").(string)
+
+	accountIDs, err := organizationalUnitAssignmentPlannedAccountIDs(d, meta)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s,%s,%s", principalID, principalType, ouID, permissionSetArn, instanceArn))
+
+	createdIDs, err := createAccountAssignments(conn, principalID, principalType, accountIDs, permissionSetArn, instanceArn)
+
+	// Record the assignments that were made, even if some failed.
+	d.Set("account_ids", accountIDs)
+	d.Set("created_account_ids", createdIDs)
+
+	if readErr := resourceOrganizationalUnitAssignmentRead(d, meta); readErr != nil {
+		err = multierror.Append(err, readErr)
+	}
+
+	return err
+}
+
+func resourceOrganizationalUnitAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	principalID, principalType, ouID, permissionSetArn, instanceArn, err := ParseOrganizationalUnitAssignmentID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Organizational Unit Assignment ID: %w", err)
+	}
+
+	memberIDs, err := findOrganizationalUnitAccountIDs(meta.(*conns.AWSClient).OrganizationsConn, ouID, d.Get("recursive").(bool))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Organizations Organizational Unit (%s) not found, removing SSO Organizational Unit Assignment (%s) from state", ouID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Organizational Unit Assignment (%s) accounts: %w", d.Id(), err)
+	}
+
+	// Check both the accounts previously assigned, which may have since left the organizational unit,
+	// and the current member accounts, which may have been assigned outside of Terraform.
+	candidateIDs := aws.StringValueSlice(flex.ExpandStringSet(d.Get("account_ids").(*schema.Set)))
+	candidateIDs = append(candidateIDs, memberIDs...)
+
+	var accountIDs []string
+	seen := make(map[string]bool)
+
+	for _, accountID := range candidateIDs {
+		if seen[accountID] {
+			continue
+		}
+
+		seen[accountID] = true
+
+		accountAssignment, err := FindAccountAssignment(conn, principalID, principalType, accountID, permissionSetArn, instanceArn)
+
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading SSO Account Assignment for Principal (%s) in account (%s): %w", principalID, accountID, err)
+		}
+
+		if accountAssignment != nil {
+			accountIDs = append(accountIDs, accountID)
+		}
+	}
+
+	// Only the assignments created by this resource are removed on destroy.
+	createdIDs := d.Get("created_account_ids").(*schema.Set).Intersection(flex.FlattenStringSet(aws.StringSlice(accountIDs)))
+
+	d.Set("account_ids", accountIDs)
+	d.Set("created_account_ids", createdIDs)
+	d.Set("instance_arn", instanceArn)
+	d.Set("organizational_unit_id", ouID)
+	d.Set("permission_set_arn", permissionSetArn)
+	d.Set("principal_id", principalID)
+	d.Set("principal_type", principalType)
+
+	return nil
+}
+
+func resourceOrganizationalUnitAssignmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	instanceArn := d.Get("instance_arn").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+	principalID := d.Get("principal_id").(string)
+	principalType := d.Get("principal_type").(string)
+
+	o, _ := d.GetChange("account_ids")
+	oldSet := o.(*schema.Set)
+	createdSet := d.Get("created_account_ids").(*schema.Set)
+
+	accountIDs, err := organizationalUnitAssignmentPlannedAccountIDs(d, meta)
+
+	if err != nil {
+		return err
+	}
+
+	newSet := flex.FlattenStringSet(aws.StringSlice(accountIDs))
+	add := aws.StringValueSlice(flex.ExpandStringSet(newSet.Difference(oldSet)))
+	del := aws.StringValueSlice(flex.ExpandStringSet(oldSet.Difference(newSet).Intersection(createdSet)))
+
+	var errs *multierror.Error
+
+	if err := deleteAccountAssignments(conn, principalID, principalType, del, permissionSetArn, instanceArn); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	createdIDs, err := createAccountAssignments(conn, principalID, principalType, add, permissionSetArn, instanceArn)
+
+	if err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	// Record the assignments that remain, even if some operations failed.
+	d.Set("account_ids", oldSet.Union(newSet))
+	d.Set("created_account_ids", createdSet.Union(flex.FlattenStringSet(aws.StringSlice(createdIDs))))
+
+	if err := resourceOrganizationalUnitAssignmentRead(d, meta); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func resourceOrganizationalUnitAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	principalID, principalType, _, permissionSetArn, instanceArn, err := ParseOrganizationalUnitAssignmentID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Organizational Unit Assignment ID: %w", err)
+	}
+
+	accountIDs := aws.StringValueSlice(flex.ExpandStringSet(d.Get("created_account_ids").(*schema.Set)))
+
+	return deleteAccountAssignments(conn, principalID, principalType, accountIDs, permissionSetArn, instanceArn)
+}
+
+// resourceOrganizationalUnitAssignmentImport adopts the existing assignments, so that they are removed on destroy.
+func resourceOrganizationalUnitAssignmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+
+	if err := resourceOrganizationalUnitAssignmentRead(d, meta); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("SSO Organizational Unit Assignment (%s) not found", id)
+	}
+
+	d.Set("created_account_ids", d.Get("account_ids"))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceOrganizationalUnitAssignmentCustomizeDiff plans the organizational unit's current member accounts
+// as the assigned accounts, so that accounts that have joined or left the organizational unit are reported as drift.
+func resourceOrganizationalUnitAssignmentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("organizational_unit_id") || !diff.NewValueKnown("recursive") {
+		return diff.SetNewComputed("account_ids")
+	}
+
+	accountIDs, err := findOrganizationalUnitAccountIDs(meta.(*conns.AWSClient).OrganizationsConn, diff.Get("organizational_unit_id").(string), diff.Get("recursive").(bool))
+
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Organizational Unit (%s) accounts: %w", diff.Get("organizational_unit_id").(string), err)
+	}
+
+	if diff.Id() != "" && diff.Get("account_ids").(*schema.Set).Equal(flex.FlattenStringSet(aws.StringSlice(accountIDs))) {
+		return nil
+	}
+
+	return diff.SetNew("account_ids", accountIDs)
+}
+
+// organizationalUnitAssignmentPlannedAccountIDs returns the planned accounts, reading the organizational unit's
+// member accounts if they were not known during planning.
+func organizationalUnitAssignmentPlannedAccountIDs(d *schema.ResourceData, meta interface{}) ([]string, error) {
+	if v := d.Get("account_ids").(*schema.Set); v.Len() > 0 {
+		return aws.StringValueSlice(flex.ExpandStringSet(v)), nil
+	}
+
+	ouID := d.Get("organizational_unit_id").(string)
+	accountIDs, err := findOrganizationalUnitAccountIDs(meta.(*conns.AWSClient).OrganizationsConn, ouID, d.Get("recursive").(bool))
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Organizations Organizational Unit (%s) accounts: %w", ouID, err)
+	}
+
+	return accountIDs, nil
+}
+
+// findOrganizationalUnitAccountIDs returns the IDs of the active accounts in the specified organizational unit (or root),
+// including the accounts in its descendant organizational units if recursive is true.
+func findOrganizationalUnitAccountIDs(conn *organizations.Organizations, parentID string, recursive bool) ([]string, error) {
+	accounts, err := tforganizations.FindAccountsForParent(conn, parentID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var accountIDs []string
+
+	for _, account := range accounts {
+		if aws.StringValue(account.Status) != organizations.AccountStatusActive {
+			continue
+		}
+
+		accountIDs = append(accountIDs, aws.StringValue(account.Id))
+	}
+
+	if recursive {
+		ous, err := tforganizations.FindOrganizationalUnitsForParent(conn, parentID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ou := range ous {
+			v, err := findOrganizationalUnitAccountIDs(conn, aws.StringValue(ou.Id), recursive)
+
+			if err != nil {
+				return nil, err
+			}
+
+			accountIDs = append(accountIDs, v...)
+		}
+	}
+
+	sort.Strings(accountIDs)
+
+	return accountIDs, nil
+}
+
+// createAccountAssignments assigns the permission set to the principal in each of the specified accounts, in parallel.
+// Accounts in which the assignment already exists are skipped. The IDs of the accounts in which an assignment was created are returned.
+func createAccountAssignments(conn *ssoadmin.SSOAdmin, principalID, principalType string, accountIDs []string, permissionSetArn, instanceArn string) ([]string, error) {
+	var createdIDs []string
+	var mu sync.Mutex
+
+	err := forEachAccountConcurrently(accountIDs, func(accountID string) error {
+		accountAssignment, err := FindAccountAssignment(conn, principalID, principalType, accountID, permissionSetArn, instanceArn)
+
+		if err != nil {
+			return fmt.Errorf("error listing SSO Account Assignments for AccountId (%s) PermissionSet (%s): %w", accountID, permissionSetArn, err)
+		}
+
+		if accountAssignment != nil {
+			log.Printf("[DEBUG] SSO Account Assignment for %s (%s) in account (%s) already exists", principalType, principalID, accountID)
+			return nil
+		}
+
+		input := &ssoadmin.CreateAccountAssignmentInput{
+			InstanceArn:      aws.String(instanceArn),
+			PermissionSetArn: aws.String(permissionSetArn),
+			PrincipalId:      aws.String(principalID),
+			PrincipalType:    aws.String(principalType),
+			TargetId:         aws.String(accountID),
+			TargetType:       aws.String(ssoadmin.TargetTypeAwsAccount),
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(awsSSOAdminAccountAssignmentCreateTimeout, func() (interface{}, error) {
+			return conn.CreateAccountAssignment(input)
+		}, ssoadmin.ErrCodeConflictException, ssoadmin.ErrCodeThrottlingException)
+
+		if err != nil {
+			return fmt.Errorf("error creating SSO Account Assignment for %s (%s) in account (%s): %w", principalType, principalID, accountID, err)
+		}
+
+		output := outputRaw.(*ssoadmin.CreateAccountAssignmentOutput)
+
+		if output == nil || output.AccountAssignmentCreationStatus == nil {
+			return fmt.Errorf("error creating SSO Account Assignment for %s (%s) in account (%s): empty output", principalType, principalID, accountID)
+		}
+
+		if _, err := waitAccountAssignmentCreated(conn, instanceArn, aws.StringValue(output.AccountAssignmentCreationStatus.RequestId)); err != nil {
+			return fmt.Errorf("error waiting for SSO Account Assignment for %s (%s) in account (%s) to be created: %w", principalType, principalID, accountID, err)
+		}
+
+		mu.Lock()
+		createdIDs = append(createdIDs, accountID)
+		mu.Unlock()
+
+		return nil
+	})
+
+	sort.Strings(createdIDs)
+
+	return createdIDs, err
+}
+
+// deleteAccountAssignments removes the permission set assignment from the principal in each of the specified accounts, in parallel.
+func deleteAccountAssignments(conn *ssoadmin.SSOAdmin, principalID, principalType string, accountIDs []string, permissionSetArn, instanceArn string) error {
+	return forEachAccountConcurrently(accountIDs, func(accountID string) error {
+		input := &ssoadmin.DeleteAccountAssignmentInput{
+			InstanceArn:      aws.String(instanceArn),
+			PermissionSetArn: aws.String(permissionSetArn),
+			PrincipalId:      aws.String(principalID),
+			PrincipalType:    aws.String(principalType),
+			TargetId:         aws.String(accountID),
+			TargetType:       aws.String(ssoadmin.TargetTypeAwsAccount),
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(awsSSOAdminAccountAssignmentDeleteTimeout, func() (interface{}, error) {
+			return conn.DeleteAccountAssignment(input)
+		}, ssoadmin.ErrCodeConflictException, ssoadmin.ErrCodeThrottlingException)
+
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting SSO Account Assignment for Principal (%s) in account (%s): %w", principalID, accountID, err)
+		}
+
+		output := outputRaw.(*ssoadmin.DeleteAccountAssignmentOutput)
+
+		if output == nil || output.AccountAssignmentDeletionStatus == nil {
+			return fmt.Errorf("error deleting SSO Account Assignment for Principal (%s) in account (%s): empty output", principalID, accountID)
+		}
+
+		if _, err := waitAccountAssignmentDeleted(conn, instanceArn, aws.StringValue(output.AccountAssignmentDeletionStatus.RequestId)); err != nil {
+			return fmt.Errorf("error waiting for SSO Account Assignment for Principal (%s) in account (%s) to be deleted: %w", principalID, accountID, err)
+		}
+
+		return nil
+	})
+}
+
+func forEachAccountConcurrently(accountIDs []string, f func(string) error) error {
+	var g multierror.Group
+	sem := make(chan struct{}, organizationalUnitAssignmentConcurrency)
+
+	for _, accountID := range accountIDs {
+		accountID := accountID
+
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			return f(accountID)
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+func ParseOrganizationalUnitAssignmentID(id string) (string, string, string, string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 5 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" || idParts[4] == "" {
+		return "", "", "", "", "", fmt.Errorf("unexpected format for ID (%q), expected PRINCIPAL_ID,PRINCIPAL_TYPE,ORGANIZATIONAL_UNIT_ID,PERMISSION_SET_ARN,INSTANCE_ARN", id)
+	}
+	return idParts[0], idParts[1], idParts[2], idParts[3], idParts[4], nil
+}
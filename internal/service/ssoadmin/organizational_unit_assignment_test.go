@@ -0,0 +1,172 @@
+package ssoadmin_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+)
+
+func TestAccSSOAdminOrganizationalUnitAssignment_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_organizational_unit_assignment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+	ouID := os.Getenv("AWS_SSOADMIN_ORGANIZATIONAL_UNIT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsEnabled(t)
+			testAccPreCheckInstances(t)
+			testAccPreCheckIdentityStoreGroupName(t)
+			testAccPreCheckOrganizationalUnitID(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckOrganizationalUnitAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationalUnitAssignmentConfig(groupName, rName, ouID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationalUnitAssignmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "created_account_ids.#", resourceName, "account_ids.#"),
+					resource.TestCheckResourceAttr(resourceName, "organizational_unit_id", ouID),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", "GROUP"),
+					resource.TestCheckResourceAttr(resourceName, "recursive", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationalUnitAssignmentConfig(groupName, rName, ouID, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationalUnitAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recursive", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationalUnitAssignmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_organizational_unit_assignment" {
+			continue
+		}
+
+		principalID, principalType, _, permissionSetArn, instanceArn, err := tfssoadmin.ParseOrganizationalUnitAssignmentID(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Organizational Unit Assignment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		for k, accountID := range rs.Primary.Attributes {
+			if k == "account_ids.#" || !strings.HasPrefix(k, "account_ids.") {
+				continue
+			}
+
+			accountAssignment, err := tfssoadmin.FindAccountAssignment(conn, principalID, principalType, accountID, permissionSetArn, instanceArn)
+
+			if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error reading SSO Account Assignment for Principal (%s) in account (%s): %w", principalID, accountID, err)
+			}
+
+			if accountAssignment != nil {
+				return fmt.Errorf("SSO Account Assignment for Principal (%s) in account (%s) still exists", principalID, accountID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckOrganizationalUnitAssignmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+		principalID, principalType, _, permissionSetArn, instanceArn, err := tfssoadmin.ParseOrganizationalUnitAssignmentID(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Organizational Unit Assignment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		if rs.Primary.Attributes["account_ids.#"] == "0" {
+			return fmt.Errorf("Organizational Unit Assignment for Principal (%s) has no accounts", principalID)
+		}
+
+		for k, accountID := range rs.Primary.Attributes {
+			if k == "account_ids.#" || !strings.HasPrefix(k, "account_ids.") {
+				continue
+			}
+
+			accountAssignment, err := tfssoadmin.FindAccountAssignment(conn, principalID, principalType, accountID, permissionSetArn, instanceArn)
+
+			if err != nil {
+				return err
+			}
+
+			if accountAssignment == nil {
+				return fmt.Errorf("Account Assignment for Principal (%s) in account (%s) not found", principalID, accountID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccOrganizationalUnitAssignmentConfig(groupName, rName, ouID string, recursive bool) string {
+	return acctest.ConfigCompose(
+		testAccAccountAssignmentBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = %[1]q
+  }
+}
+
+resource "aws_ssoadmin_organizational_unit_assignment" "test" {
+  instance_arn           = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn     = aws_ssoadmin_permission_set.test.arn
+  organizational_unit_id = %[2]q
+  recursive              = %[3]t
+  principal_type         = "GROUP"
+  principal_id           = data.aws_identitystore_group.test.group_id
+}
+`, groupName, ouID, recursive))
+}
+
+func testAccPreCheckOrganizationalUnitID(t *testing.T) {
+	if os.Getenv("AWS_SSOADMIN_ORGANIZATIONAL_UNIT_ID") == "" {
+		t.Skip("AWS_SSOADMIN_ORGANIZATIONAL_UNIT_ID env var must be set for SSO Organizational Unit Assignment acceptance test. " +
+			"The organizational unit must contain at least one active account.")
+	}
+}
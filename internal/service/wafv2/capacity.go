@@ -0,0 +1,161 @@
+package wafv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+const (
+	// webACLCapacityLimit is the default maximum number of web ACL capacity units (WCUs) that a web ACL can use.
+	webACLCapacityLimit = 1500
+)
+
+// matchStatementCost is the published WCU cost of a match statement.
+// https://docs.aws.amazon.com/waf/latest/developerguide/waf-rule-statements-match.html
+type matchStatementCost struct {
+	base int64
+	// allQueryArguments is added to the base cost when inspecting all query arguments.
+	allQueryArguments int64
+	// jsonBodyFactor multiplies the base cost when inspecting the JSON body.
+	jsonBodyFactor int64
+}
+
+var (
+	byteMatchStatementCost                = matchStatementCost{base: 2, allQueryArguments: 10, jsonBodyFactor: 2}
+	byteMatchStatementContainsCost        = matchStatementCost{base: 10, allQueryArguments: 10, jsonBodyFactor: 2}
+	regexPatternSetReferenceStatementCost = matchStatementCost{base: 25, allQueryArguments: 10, jsonBodyFactor: 2}
+	sizeConstraintStatementCost           = matchStatementCost{base: 1, allQueryArguments: 10, jsonBodyFactor: 2}
+	sqliMatchStatementCost                = matchStatementCost{base: 20, allQueryArguments: 10, jsonBodyFactor: 2}
+	xssMatchStatementCost                 = matchStatementCost{base: 40, allQueryArguments: 10, jsonBodyFactor: 2}
+)
+
+// rulesCapacity returns the web ACL capacity units (WCUs) used by the rules, using the published WCU costs of rule statements.
+// The result is incomplete, i.e. a lower bound, if any rule references a rule group or managed rule group,
+// as the capacity of those cannot be determined locally.
+func rulesCapacity(rules []*wafv2.Rule) (int64, bool) {
+	var capacity int64
+	complete := true
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		v, ok := statementCapacity(rule.Statement)
+		capacity += v
+		complete = complete && ok
+	}
+
+	return capacity, complete
+}
+
+func statementsCapacity(statements []*wafv2.Statement) (int64, bool) {
+	var capacity int64
+	complete := true
+
+	for _, statement := range statements {
+		v, ok := statementCapacity(statement)
+		capacity += v
+		complete = complete && ok
+	}
+
+	return capacity, complete
+}
+
+// statementCapacity returns the WCUs used by a single statement.
+// Logical statements cost the sum of their nested statements.
+func statementCapacity(s *wafv2.Statement) (int64, bool) {
+	if s == nil {
+		return 0, true
+	}
+
+	switch {
+	case s.AndStatement != nil:
+		return statementsCapacity(s.AndStatement.Statements)
+	case s.OrStatement != nil:
+		return statementsCapacity(s.OrStatement.Statements)
+	case s.NotStatement != nil:
+		return statementCapacity(s.NotStatement.Statement)
+	case s.ByteMatchStatement != nil:
+		v := s.ByteMatchStatement
+		cost := byteMatchStatementCost
+
+		switch aws.StringValue(v.PositionalConstraint) {
+		case wafv2.PositionalConstraintContains, wafv2.PositionalConstraintContainsWord:
+			cost = byteMatchStatementContainsCost
+		}
+
+		return cost.capacity(v.FieldToMatch, v.TextTransformations), true
+	case s.GeoMatchStatement != nil, s.IPSetReferenceStatement != nil, s.LabelMatchStatement != nil:
+		return 1, true
+	case s.RateBasedStatement != nil:
+		v, ok := statementCapacity(s.RateBasedStatement.ScopeDownStatement)
+
+		return 2 + v, ok
+	case s.RegexPatternSetReferenceStatement != nil:
+		v := s.RegexPatternSetReferenceStatement
+
+		return regexPatternSetReferenceStatementCost.capacity(v.FieldToMatch, v.TextTransformations), true
+	case s.SizeConstraintStatement != nil:
+		v := s.SizeConstraintStatement
+
+		return sizeConstraintStatementCost.capacity(v.FieldToMatch, v.TextTransformations), true
+	case s.SqliMatchStatement != nil:
+		v := s.SqliMatchStatement
+
+		return sqliMatchStatementCost.capacity(v.FieldToMatch, v.TextTransformations), true
+	case s.XssMatchStatement != nil:
+		v := s.XssMatchStatement
+
+		return xssMatchStatementCost.capacity(v.FieldToMatch, v.TextTransformations), true
+	case s.ManagedRuleGroupStatement != nil, s.RuleGroupReferenceStatement != nil:
+		return 0, false
+	}
+
+	return 0, true
+}
+
+// capacity returns the cost of a match statement inspecting the specified field with the specified text transformations.
+func (c matchStatementCost) capacity(f *wafv2.FieldToMatch, transformations []*wafv2.TextTransformation) int64 {
+	capacity := c.base
+
+	if f != nil {
+		switch {
+		case f.AllQueryArguments != nil:
+			capacity += c.allQueryArguments
+		case f.JsonBody != nil:
+			capacity *= c.jsonBodyFactor
+		}
+	}
+
+	return capacity + textTransformationsCapacity(transformations)
+}
+
+// textTransformationsCapacity returns the cost of text transformations, which is 10 WCUs for each transformation other than NONE.
+func textTransformationsCapacity(transformations []*wafv2.TextTransformation) int64 {
+	var capacity int64
+
+	for _, v := range transformations {
+		if v != nil && aws.StringValue(v.Type) != wafv2.TextTransformationTypeNone {
+			capacity += 10
+		}
+	}
+
+	return capacity
+}
+
+// checkCapacity returns the WCUs used by the rules as calculated by the WAFv2 API.
+func checkCapacity(conn *wafv2.WAFV2, scope string, rules []*wafv2.Rule) (int64, error) {
+	output, err := conn.CheckCapacity(&wafv2.CheckCapacityInput{
+		Rules: rules,
+		Scope: aws.String(scope),
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("error checking WAFv2 capacity: %w", err)
+	}
+
+	return aws.Int64Value(output.Capacity), nil
+}
@@ -0,0 +1,181 @@
+package wafv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+func TestRulesCapacity(t *testing.T) {
+	none := &wafv2.TextTransformation{Priority: aws.Int64(0), Type: aws.String(wafv2.TextTransformationTypeNone)}
+	lowercase := &wafv2.TextTransformation{Priority: aws.Int64(1), Type: aws.String(wafv2.TextTransformationTypeLowercase)}
+	urlDecode := &wafv2.TextTransformation{Priority: aws.Int64(2), Type: aws.String(wafv2.TextTransformationTypeUrlDecode)}
+	geo := &wafv2.Statement{GeoMatchStatement: &wafv2.GeoMatchStatement{CountryCodes: aws.StringSlice([]string{"US"})}}
+
+	cases := []struct {
+		Name             string
+		Statements       []*wafv2.Statement
+		ExpectedCapacity int64
+		ExpectedComplete bool
+	}{
+		{
+			Name:             "no rules",
+			ExpectedComplete: true,
+		},
+		{
+			Name: "byte match exactly",
+			Statements: []*wafv2.Statement{{ByteMatchStatement: &wafv2.ByteMatchStatement{
+				PositionalConstraint: aws.String(wafv2.PositionalConstraintExactly),
+				FieldToMatch:         &wafv2.FieldToMatch{UriPath: &wafv2.UriPath{}},
+				TextTransformations:  []*wafv2.TextTransformation{none},
+			}}},
+			ExpectedCapacity: 2,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "byte match exactly all query arguments",
+			Statements: []*wafv2.Statement{{ByteMatchStatement: &wafv2.ByteMatchStatement{
+				PositionalConstraint: aws.String(wafv2.PositionalConstraintExactly),
+				FieldToMatch:         &wafv2.FieldToMatch{AllQueryArguments: &wafv2.AllQueryArguments{}},
+				TextTransformations:  []*wafv2.TextTransformation{none},
+			}}},
+			ExpectedCapacity: 12,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "byte match starts with json body",
+			Statements: []*wafv2.Statement{{ByteMatchStatement: &wafv2.ByteMatchStatement{
+				PositionalConstraint: aws.String(wafv2.PositionalConstraintStartsWith),
+				FieldToMatch:         &wafv2.FieldToMatch{JsonBody: &wafv2.JsonBody{}},
+				TextTransformations:  []*wafv2.TextTransformation{lowercase},
+			}}},
+			ExpectedCapacity: 14,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "byte match contains all query arguments",
+			Statements: []*wafv2.Statement{{ByteMatchStatement: &wafv2.ByteMatchStatement{
+				PositionalConstraint: aws.String(wafv2.PositionalConstraintContains),
+				FieldToMatch:         &wafv2.FieldToMatch{AllQueryArguments: &wafv2.AllQueryArguments{}},
+				TextTransformations:  []*wafv2.TextTransformation{none, lowercase},
+			}}},
+			ExpectedCapacity: 30,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "regex pattern set",
+			Statements: []*wafv2.Statement{{RegexPatternSetReferenceStatement: &wafv2.RegexPatternSetReferenceStatement{
+				FieldToMatch:        &wafv2.FieldToMatch{Body: &wafv2.Body{}},
+				TextTransformations: []*wafv2.TextTransformation{lowercase, urlDecode},
+			}}},
+			ExpectedCapacity: 45,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "sqli and xss",
+			Statements: []*wafv2.Statement{
+				{SqliMatchStatement: &wafv2.SqliMatchStatement{
+					FieldToMatch:        &wafv2.FieldToMatch{Body: &wafv2.Body{}},
+					TextTransformations: []*wafv2.TextTransformation{urlDecode},
+				}},
+				{XssMatchStatement: &wafv2.XssMatchStatement{
+					FieldToMatch:        &wafv2.FieldToMatch{AllQueryArguments: &wafv2.AllQueryArguments{}},
+					TextTransformations: []*wafv2.TextTransformation{none},
+				}},
+			},
+			ExpectedCapacity: 80,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "size constraint",
+			Statements: []*wafv2.Statement{{SizeConstraintStatement: &wafv2.SizeConstraintStatement{
+				FieldToMatch:        &wafv2.FieldToMatch{QueryString: &wafv2.QueryString{}},
+				TextTransformations: []*wafv2.TextTransformation{none, lowercase},
+			}}},
+			ExpectedCapacity: 11,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "size constraint all query arguments and json body",
+			Statements: []*wafv2.Statement{
+				{SizeConstraintStatement: &wafv2.SizeConstraintStatement{
+					FieldToMatch:        &wafv2.FieldToMatch{AllQueryArguments: &wafv2.AllQueryArguments{}},
+					TextTransformations: []*wafv2.TextTransformation{none},
+				}},
+				{SizeConstraintStatement: &wafv2.SizeConstraintStatement{
+					FieldToMatch:        &wafv2.FieldToMatch{JsonBody: &wafv2.JsonBody{}},
+					TextTransformations: []*wafv2.TextTransformation{none},
+				}},
+			},
+			ExpectedCapacity: 13,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "regex pattern set all query arguments",
+			Statements: []*wafv2.Statement{{RegexPatternSetReferenceStatement: &wafv2.RegexPatternSetReferenceStatement{
+				FieldToMatch:        &wafv2.FieldToMatch{AllQueryArguments: &wafv2.AllQueryArguments{}},
+				TextTransformations: []*wafv2.TextTransformation{none},
+			}}},
+			ExpectedCapacity: 35,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "geo, ip set and label match",
+			Statements: []*wafv2.Statement{
+				geo,
+				{IPSetReferenceStatement: &wafv2.IPSetReferenceStatement{}},
+				{LabelMatchStatement: &wafv2.LabelMatchStatement{}},
+			},
+			ExpectedCapacity: 3,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "nested logical statements",
+			Statements: []*wafv2.Statement{{OrStatement: &wafv2.OrStatement{Statements: []*wafv2.Statement{
+				{NotStatement: &wafv2.NotStatement{Statement: geo}},
+				{AndStatement: &wafv2.AndStatement{Statements: []*wafv2.Statement{geo, geo}}},
+			}}}},
+			ExpectedCapacity: 3,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "rate based with scope down",
+			Statements: []*wafv2.Statement{{RateBasedStatement: &wafv2.RateBasedStatement{
+				ScopeDownStatement: &wafv2.Statement{NotStatement: &wafv2.NotStatement{Statement: geo}},
+			}}},
+			ExpectedCapacity: 3,
+			ExpectedComplete: true,
+		},
+		{
+			Name: "rule group references",
+			Statements: []*wafv2.Statement{
+				geo,
+				{ManagedRuleGroupStatement: &wafv2.ManagedRuleGroupStatement{}},
+				{RuleGroupReferenceStatement: &wafv2.RuleGroupReferenceStatement{}},
+			},
+			ExpectedCapacity: 1,
+			ExpectedComplete: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var rules []*wafv2.Rule
+
+			for _, statement := range tc.Statements {
+				rules = append(rules, &wafv2.Rule{Statement: statement})
+			}
+
+			capacity, complete := rulesCapacity(rules)
+
+			if capacity != tc.ExpectedCapacity {
+				t.Errorf("expected capacity %d, got %d", tc.ExpectedCapacity, capacity)
+			}
+
+			if complete != tc.ExpectedComplete {
+				t.Errorf("expected complete %t, got %t", tc.ExpectedComplete, complete)
+			}
+		})
+	}
+}
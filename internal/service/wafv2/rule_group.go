@@ -1,6 +1,7 @@
 package wafv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,6 +39,7 @@ func ResourceRuleGroup() *schema.Resource {
 				d.SetId(id)
 				d.Set("name", name)
 				d.Set("scope", scope)
+				d.Set("check_capacity", false)
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -46,12 +49,22 @@ func ResourceRuleGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"calculated_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"capacity": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
+			"check_capacity": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"custom_response_body": customResponseBodySchema(),
 			"description": {
 				Type:         schema.TypeString,
@@ -117,7 +130,10 @@ func ResourceRuleGroup() *schema.Resource {
 			"visibility_config": visibilityConfigSchema(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRuleGroupCapacityCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -203,6 +219,8 @@ func resourceRuleGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", resp.RuleGroup.Name)
 	d.Set("capacity", resp.RuleGroup.Capacity)
+	capacity, _ := rulesCapacity(resp.RuleGroup.Rules)
+	d.Set("calculated_capacity", capacity)
 	d.Set("description", resp.RuleGroup.Description)
 	d.Set("arn", resp.RuleGroup.ARN)
 	d.Set("lock_token", resp.LockToken)
@@ -326,3 +344,62 @@ func resourceRuleGroupDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// resourceRuleGroupCapacityCustomizeDiff calculates the capacity required by the rule group's rules.
+// A configured capacity that is too small for the rules is reported at plan time.
+// If capacity isn't configured it defaults to the calculated capacity, and the rule group is only replaced once its rules no longer fit.
+func resourceRuleGroupCapacityCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges("capacity", "check_capacity", "rule") {
+		return nil
+	}
+
+	rules := expandRules(diff.Get("rule").(*schema.Set).List())
+	calculated, _ := rulesCapacity(rules)
+
+	if err := diff.SetNew("calculated_capacity", calculated); err != nil {
+		return err
+	}
+
+	config := diff.GetRawConfig().GetAttr("capacity")
+
+	if !config.IsKnown() {
+		return nil
+	}
+
+	capacity := int64(diff.Get("capacity").(int))
+
+	if config.IsNull() {
+		// Rule groups require a capacity of at least 1, even without rules.
+		required := calculated
+
+		if required < 1 {
+			required = 1
+		}
+
+		if capacity < required {
+			capacity = required
+
+			if err := diff.SetNew("capacity", capacity); err != nil {
+				return err
+			}
+		}
+	} else if calculated > capacity {
+		return fmt.Errorf("WAFv2 RuleGroup rules require %d capacity units (WCUs), more than its capacity (%d)", calculated, capacity)
+	}
+
+	if !diff.Get("check_capacity").(bool) || len(rules) == 0 || !diff.GetRawConfig().GetAttr("rule").IsWhollyKnown() {
+		return nil
+	}
+
+	checked, err := checkCapacity(meta.(*conns.AWSClient).WAFV2Conn, diff.Get("scope").(string), rules)
+
+	if err != nil {
+		return err
+	}
+
+	if checked > capacity {
+		return fmt.Errorf("WAFv2 RuleGroup rules require %d capacity units (WCUs) according to CheckCapacity, more than its capacity (%d)", checked, capacity)
+	}
+
+	return nil
+}
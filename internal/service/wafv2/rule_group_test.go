@@ -54,6 +54,52 @@ func TestAccWAFV2RuleGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2RuleGroup_calculatedCapacity(t *testing.T) {
+	var before, after wafv2.RuleGroup
+	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_CalculatedCapacity(ruleGroupName, "capacity = 10", false),
+				ExpectError: regexp.MustCompile(`rules require 50 capacity units \(WCUs\), more than its capacity \(10\)`),
+			},
+			{
+				Config: testAccRuleGroupConfig_CalculatedCapacity(ruleGroupName, "", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "calculated_capacity", "50"),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "50"),
+					resource.TestCheckResourceAttr(resourceName, "check_capacity", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccRuleGroupImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccRuleGroupConfig_CalculatedCapacity(ruleGroupName, "", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(resourceName, &after),
+					testAccCheckRuleGroupRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "calculated_capacity", "51"),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "51"),
+					resource.TestCheckResourceAttr(resourceName, "check_capacity", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccWAFV2RuleGroup_updateRule(t *testing.T) {
 	var v wafv2.RuleGroup
 	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1756,6 +1802,16 @@ func testAccCheckRuleGroupExists(n string, v *wafv2.RuleGroup) resource.TestChec
 	}
 }
 
+func testAccCheckRuleGroupRecreated(before, after *wafv2.RuleGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Id), aws.StringValue(after.Id); before == after {
+			return fmt.Errorf("WAFv2 RuleGroup (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
 func testAccRuleGroupConfig_Basic(name string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_rule_group" "test" {
@@ -1919,6 +1975,78 @@ resource "aws_wafv2_rule_group" "test" {
 `, name, name)
 }
 
+func testAccRuleGroupConfig_CalculatedCapacity(name, capacity string, geoMatch bool) string {
+	geoMatchRule := ""
+
+	if geoMatch {
+		geoMatchRule = `
+  rule {
+    name     = "rule-2"
+    priority = 2
+
+    action {
+      block {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["NL"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "aws_wafv2_rule_group" "test" {
+  %[2]s
+  check_capacity = %[3]t
+  name           = %[1]q
+  scope          = "REGIONAL"
+
+  rule {
+    name     = "rule-1"
+    priority = 1
+
+    action {
+      allow {}
+    }
+
+    statement {
+      xss_match_statement {
+        field_to_match {
+          body {}
+        }
+
+        text_transformation {
+          priority = 1
+          type     = "URL_DECODE"
+        }
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+%[4]s
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name, capacity, geoMatch, geoMatchRule)
+}
+
 func testAccRuleGroupConfig_Minimal(name string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_rule_group" "test" {
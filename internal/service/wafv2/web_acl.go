@@ -1,6 +1,7 @@
 package wafv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -43,6 +45,8 @@ func ResourceWebACL() *schema.Resource {
 				d.SetId(id)
 				d.Set("name", name)
 				d.Set("scope", scope)
+				d.Set("capacity_limit", webACLCapacityLimit)
+				d.Set("check_capacity", false)
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -52,10 +56,25 @@ func ResourceWebACL() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"calculated_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"capacity": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"capacity_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      webACLCapacityLimit,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"check_capacity": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"custom_response_body": customResponseBodySchema(),
 			"default_action": {
 				Type:     schema.TypeList,
@@ -143,7 +162,10 @@ func ResourceWebACL() *schema.Resource {
 			"visibility_config": visibilityConfigSchema(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceWebACLCapacityCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -229,6 +251,11 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", resp.WebACL.Name)
 	d.Set("capacity", resp.WebACL.Capacity)
+	if capacity, complete := rulesCapacity(resp.WebACL.Rules); complete {
+		d.Set("calculated_capacity", capacity)
+	} else {
+		d.Set("calculated_capacity", resp.WebACL.Capacity)
+	}
 	d.Set("description", resp.WebACL.Description)
 	d.Set("arn", resp.WebACL.ARN)
 	d.Set("lock_token", resp.LockToken)
@@ -365,6 +392,45 @@ func resourceWebACLDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceWebACLCapacityCustomizeDiff calculates the capacity used by the web ACL's rules,
+// so that rules exceeding the web ACL capacity limit are reported at plan time rather than when the web ACL is updated.
+// The capacity of referenced rule groups and managed rule groups can only be determined by CheckCapacity.
+func resourceWebACLCapacityCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges("capacity_limit", "check_capacity", "rule") {
+		return nil
+	}
+
+	rules := expandWebACLRules(diff.Get("rule").(*schema.Set).List())
+	calculated, complete := rulesCapacity(rules)
+	limit := int64(diff.Get("capacity_limit").(int))
+
+	if calculated > limit {
+		return fmt.Errorf("WAFv2 WebACL rules require at least %d capacity units (WCUs), more than the limit of %d", calculated, limit)
+	}
+
+	if diff.Get("check_capacity").(bool) && len(rules) > 0 && diff.GetRawConfig().GetAttr("rule").IsWhollyKnown() {
+		checked, err := checkCapacity(meta.(*conns.AWSClient).WAFV2Conn, diff.Get("scope").(string), rules)
+
+		if err != nil {
+			return err
+		}
+
+		if checked > limit {
+			return fmt.Errorf("WAFv2 WebACL rules require %d capacity units (WCUs) according to CheckCapacity, more than the limit of %d", checked, limit)
+		}
+
+		if !complete {
+			calculated, complete = checked, true
+		}
+	}
+
+	if !complete {
+		return diff.SetNewComputed("calculated_capacity")
+	}
+
+	return diff.SetNew("calculated_capacity", calculated)
+}
+
 func webACLRootStatementSchema(level int) *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -628,6 +628,50 @@ func TestAccWAFV2WebACL_ManagedRuleGroup_specifyVersion(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACL_checkCapacity(t *testing.T) {
+	var v wafv2.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLConfig_GeoMatchStatement(webACLName, `"US","NL"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "calculated_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "check_capacity", "false"),
+				),
+			},
+			{
+				Config: testAccWebACLConfig_CheckCapacity(webACLName, 1500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "calculated_capacity", resourceName, "capacity"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limit", "1500"),
+					resource.TestCheckResourceAttr(resourceName, "check_capacity", "true"),
+				),
+			},
+			{
+				Config:      testAccWebACLConfig_CheckCapacity(webACLName, 500),
+				ExpectError: regexp.MustCompile(`according to CheckCapacity, more than the limit of 500`),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"check_capacity"},
+				ImportStateIdFunc:       testAccWebACLImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
 func TestAccWAFV2WebACL_minimal(t *testing.T) {
 	var v wafv2.WebACL
 	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2356,6 +2400,50 @@ resource "aws_wafv2_web_acl" "test" {
 `, name, fallbackBehavior, headerName, position)
 }
 
+func testAccWebACLConfig_CheckCapacity(name string, limit int) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  capacity_limit = %[2]d
+  check_capacity = true
+  name           = %[1]q
+  description    = %[1]q
+  scope          = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rule {
+    name     = "rule-1"
+    priority = 1
+
+    override_action {
+      none {}
+    }
+
+    statement {
+      managed_rule_group_statement {
+        name        = "AWSManagedRulesCommonRuleSet"
+        vendor_name = "AWS"
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name, limit)
+}
+
 func testAccWebACLConfig_ManagedRuleGroupStatement(name string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {